@@ -0,0 +1,317 @@
+package xlsx
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/anfilat/xlsx-sax/internal/xml"
+)
+
+// autoFilter is an autoFilter element. Its first row holds the headers and
+// the criteria apply to the rows below.
+type autoFilter struct {
+	ref     Range
+	hasRef  bool
+	columns []columnFilter
+}
+
+// columnFilter holds the criteria of a filter column. Filters that can't be
+// evaluated from cell values, like color or top 10 filters, are unsupported
+// and exclude no rows. Date group items of value filters aren't evaluated
+// either, numbers and dates pass them while the plain values still apply.
+type columnFilter struct {
+	colID       int
+	unsupported bool
+
+	hasValues  bool
+	values     map[string]bool
+	blank      bool
+	dateGroups bool
+
+	custom []customFilter
+	and    bool
+}
+
+type customFilter struct {
+	operator string
+	val      string
+}
+
+func autoFilterTagAttrs() []xml.TagAttrs {
+	return []xml.TagAttrs{
+		{
+			Name: "autoFilter",
+			Attr: []xml.TagAttr{
+				{Name: "ref"},
+			},
+		},
+		{
+			Name: "filterColumn",
+			Attr: []xml.TagAttr{
+				{Name: "colId"},
+			},
+		},
+		{
+			Name: "filters",
+			Attr: []xml.TagAttr{
+				{Name: "blank"},
+			},
+		},
+		{
+			Name: "filter",
+			Attr: []xml.TagAttr{
+				{Name: "val"},
+			},
+		},
+		{
+			Name: "customFilters",
+			Attr: []xml.TagAttr{
+				{Name: "and"},
+			},
+		},
+		{
+			Name: "customFilter",
+			Attr: []xml.TagAttr{
+				{Name: "operator"},
+				{Name: "val"},
+			},
+		},
+	}
+}
+
+// readAutoFilter reads an autoFilter element including its end element.
+func readAutoFilter(decoder *xml.Decoder, start *xml.StartElement) (*autoFilter, error) {
+	f := &autoFilter{}
+	for _, a := range start.Attr {
+		if a.Name.Local == "ref" {
+			r, err := ParseRange(a.Value.String())
+			if err != nil {
+				return nil, err
+			}
+			f.ref = r
+			f.hasRef = true
+		}
+	}
+
+	var column *columnFilter
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := t.(type) {
+		case *xml.StartElement:
+			switch token.Name.Local {
+			case "filterColumn":
+				f.columns = append(f.columns, columnFilter{})
+				column = &f.columns[len(f.columns)-1]
+				for _, a := range token.Attr {
+					if a.Name.Local == "colId" {
+						column.colID, err = strconv.Atoi(a.Value.String())
+						if err != nil {
+							return nil, ErrIncorrectSheet
+						}
+					}
+				}
+			case "filters":
+				if column == nil {
+					break
+				}
+				column.hasValues = true
+				column.values = make(map[string]bool)
+				for _, a := range token.Attr {
+					if a.Name.Local == "blank" {
+						column.blank = parseBoolAttr(a.Value.Bytes())
+					}
+				}
+			case "filter":
+				if column == nil || column.values == nil {
+					break
+				}
+				for _, a := range token.Attr {
+					if a.Name.Local == "val" {
+						column.values[strings.ToLower(a.Value.String())] = true
+					}
+				}
+			case "customFilters":
+				if column == nil {
+					break
+				}
+				for _, a := range token.Attr {
+					if a.Name.Local == "and" {
+						column.and = parseBoolAttr(a.Value.Bytes())
+					}
+				}
+			case "customFilter":
+				if column == nil {
+					break
+				}
+				cf := customFilter{operator: "equal"}
+				for _, a := range token.Attr {
+					switch a.Name.Local {
+					case "operator":
+						cf.operator = a.Value.String()
+					case "val":
+						cf.val = a.Value.String()
+					}
+				}
+				column.custom = append(column.custom, cf)
+			case "dateGroupItem":
+				if column != nil {
+					column.dateGroups = true
+				}
+				if er := decoder.Skip(); er != nil {
+					return nil, er
+				}
+			case "top10", "dynamicFilter", "colorFilter", "iconFilter":
+				if column != nil {
+					column.unsupported = true
+				}
+				if er := decoder.Skip(); er != nil {
+					return nil, er
+				}
+			case "sortState", "extLst":
+				if er := decoder.Skip(); er != nil {
+					return nil, er
+				}
+			}
+		case *xml.EndElement:
+			switch token.Name.Local {
+			case "autoFilter":
+				return f, nil
+			case "filterColumn":
+				column = nil
+			}
+		}
+	}
+}
+
+// match reports whether a cell with the given displayed text and raw value
+// passes the column criteria.
+func (c *columnFilter) match(text, value string) bool {
+	if c.unsupported {
+		return true
+	}
+
+	if c.hasValues {
+		if text == "" {
+			if !c.blank {
+				return false
+			}
+		} else if !c.values[strings.ToLower(text)] && !(c.dateGroups && mayBeDate(value)) {
+			return false
+		}
+	}
+
+	if len(c.custom) == 0 {
+		return true
+	}
+	for _, cf := range c.custom {
+		ok := cf.match(text, value)
+		if c.and && !ok {
+			return false
+		}
+		if !c.and && ok {
+			return true
+		}
+	}
+	return c.and
+}
+
+// mayBeDate reports whether a raw cell value is a serial date or an ISO 8601
+// date, which could fall into a date group.
+func mayBeDate(value string) bool {
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return true
+	}
+	_, err := parseISODate(value, false)
+	return err == nil
+}
+
+func (cf customFilter) match(text, value string) bool {
+	if filterNum, err := strconv.ParseFloat(cf.val, 64); err == nil {
+		if num, er := strconv.ParseFloat(value, 64); er == nil {
+			return compareFilter(cf.operator, compareFloat(num, filterNum))
+		}
+	}
+
+	text = strings.ToLower(text)
+	val := strings.ToLower(cf.val)
+	switch cf.operator {
+	case "equal", "notEqual":
+		return matchWildcard(val, text) == (cf.operator == "equal")
+	}
+	return compareFilter(cf.operator, strings.Compare(text, val))
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFilter(operator string, cmp int) bool {
+	switch operator {
+	case "equal":
+		return cmp == 0
+	case "notEqual":
+		return cmp != 0
+	case "lessThan":
+		return cmp < 0
+	case "lessThanOrEqual":
+		return cmp <= 0
+	case "greaterThan":
+		return cmp > 0
+	case "greaterThanOrEqual":
+		return cmp >= 0
+	}
+	return true
+}
+
+// matchWildcard matches text against an Excel pattern, where * matches any
+// sequence, ? matches one character and ~ escapes the next one.
+func matchWildcard(pattern, text string) bool {
+	p := []rune(pattern)
+	t := []rune(text)
+	pi, ti := 0, 0
+	star, mark := -1, 0
+	for ti < len(t) {
+		if pi < len(p) {
+			switch {
+			case p[pi] == '*':
+				star, mark = pi, ti
+				pi++
+				continue
+			case p[pi] == '?':
+				pi++
+				ti++
+				continue
+			case p[pi] == '~' && pi+1 < len(p):
+				if p[pi+1] == t[ti] {
+					pi += 2
+					ti++
+					continue
+				}
+			case p[pi] == t[ti]:
+				pi++
+				ti++
+				continue
+			}
+		}
+		if star < 0 {
+			return false
+		}
+		pi = star + 1
+		mark++
+		ti = mark
+	}
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+	return pi == len(p)
+}
@@ -0,0 +1,228 @@
+package xlsx
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// legacyPalette is the default indexed color palette, the last two entries
+// are the system foreground and background colors.
+var legacyPalette = []string{
+	"000000", "FFFFFF", "FF0000", "00FF00", "0000FF", "FFFF00", "FF00FF", "00FFFF",
+	"000000", "FFFFFF", "FF0000", "00FF00", "0000FF", "FFFF00", "FF00FF", "00FFFF",
+	"800000", "008000", "000080", "808000", "800080", "008080", "C0C0C0", "808080",
+	"9999FF", "993366", "FFFFCC", "CCFFFF", "660066", "FF8080", "0066CC", "CCCCFF",
+	"000080", "FF00FF", "FFFF00", "00FFFF", "800080", "800000", "008080", "0000FF",
+	"00CCFF", "CCFFFF", "CCFFCC", "FFFF99", "99CCFF", "FF99CC", "CC99FF", "FFCC99",
+	"3366FF", "33CCCC", "99CC00", "FFCC00", "FF9900", "FF6600", "666699", "969696",
+	"003366", "339966", "003300", "333300", "993300", "993366", "333399", "333333",
+	"000000", "FFFFFF",
+}
+
+// themeColorIndex maps the clrScheme elements to the theme attribute of
+// colors, which swaps the dark and light pairs.
+var themeColorIndex = map[string]int{
+	"lt1":      0,
+	"dk1":      1,
+	"lt2":      2,
+	"dk2":      3,
+	"accent1":  4,
+	"accent2":  5,
+	"accent3":  6,
+	"accent4":  7,
+	"accent5":  8,
+	"accent6":  9,
+	"hlink":    10,
+	"folHlink": 11,
+}
+
+type themeXML struct {
+	XMLName   xml.Name `xml:"theme"`
+	ClrScheme struct {
+		Colors []struct {
+			XMLName xml.Name
+			SrgbClr *struct {
+				Val string `xml:"val,attr"`
+			} `xml:"srgbClr"`
+			SysClr *struct {
+				LastClr string `xml:"lastClr,attr"`
+			} `xml:"sysClr"`
+		} `xml:",any"`
+	} `xml:"themeElements>clrScheme"`
+}
+
+// readTheme returns the RGB values of the theme colors by theme index.
+func readTheme(reader io.Reader) ([]string, error) {
+	decoder := xml.NewDecoder(reader)
+	data := &themeXML{}
+	err := decoder.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, len(themeColorIndex))
+	for _, c := range data.ClrScheme.Colors {
+		i, ok := themeColorIndex[c.XMLName.Local]
+		if !ok {
+			continue
+		}
+		switch {
+		case c.SrgbClr != nil:
+			result[i] = strings.ToUpper(c.SrgbClr.Val)
+		case c.SysClr != nil:
+			result[i] = strings.ToUpper(c.SysClr.LastClr)
+		}
+	}
+	return result, nil
+}
+
+// colorResolver turns color references into ARGB values using the theme and
+// the indexed palette of the workbook. themeErr is the error of reading the
+// theme, the resolver has no theme colors then.
+type colorResolver struct {
+	theme    []string
+	indexed  []string
+	themeErr error
+}
+
+func (r *colorResolver) resolve(c *Color) {
+	rgb := ""
+	switch c.Kind {
+	case ColorRGB:
+		rgb = c.RGB
+	case ColorTheme:
+		if r != nil && c.Theme >= 0 && c.Theme < len(r.theme) {
+			rgb = r.theme[c.Theme]
+		}
+	case ColorIndexed:
+		palette := legacyPalette
+		if r != nil && len(r.indexed) > 0 {
+			palette = r.indexed
+		}
+		if c.Indexed >= 0 && c.Indexed < len(palette) {
+			rgb = palette[c.Indexed]
+		} else if c.Indexed >= 0 && c.Indexed < len(legacyPalette) {
+			rgb = legacyPalette[c.Indexed]
+		}
+	}
+
+	c.ARGB = ""
+	alpha, red, green, blue, ok := parseARGB(rgb)
+	if !ok {
+		return
+	}
+	if c.Tint != 0 {
+		red, green, blue = applyTint(red, green, blue, c.Tint)
+	}
+	c.ARGB = fmt.Sprintf("%02X%02X%02X%02X", alpha, red, green, blue)
+}
+
+func parseARGB(rgb string) (alpha, red, green, blue uint8, ok bool) {
+	switch len(rgb) {
+	case 6:
+		rgb = "FF" + rgb
+	case 8:
+	default:
+		return 0, 0, 0, 0, false
+	}
+
+	v, err := strconv.ParseUint(rgb, 16, 32)
+	if err != nil {
+		return 0, 0, 0, 0, false
+	}
+	return uint8(v >> 24), uint8(v >> 16), uint8(v >> 8), uint8(v), true
+}
+
+// applyTint lightens or darkens a color by changing its luminance in the HLS
+// space, a negative tint darkens and a positive one lightens.
+func applyTint(red, green, blue uint8, tint float64) (uint8, uint8, uint8) {
+	h, l, s := rgbToHLS(float64(red)/255, float64(green)/255, float64(blue)/255)
+	if tint < 0 {
+		l *= 1 + tint
+	} else {
+		l = l*(1-tint) + tint
+	}
+	r, g, b := hlsToRGB(h, l, s)
+	return toByte(r), toByte(g), toByte(b)
+}
+
+func toByte(v float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(1, v)) * 255))
+}
+
+func rgbToHLS(r, g, b float64) (h, l, s float64) {
+	maxc := math.Max(r, math.Max(g, b))
+	minc := math.Min(r, math.Min(g, b))
+	l = (maxc + minc) / 2
+	if maxc == minc {
+		return 0, l, 0
+	}
+
+	d := maxc - minc
+	if l <= 0.5 {
+		s = d / (maxc + minc)
+	} else {
+		s = d / (2 - maxc - minc)
+	}
+
+	switch maxc {
+	case r:
+		h = (g - b) / d
+	case g:
+		h = 2 + (b-r)/d
+	default:
+		h = 4 + (r-g)/d
+	}
+	h /= 6
+	if h < 0 {
+		h++
+	}
+	return h, l, s
+}
+
+func hlsToRGB(h, l, s float64) (r, g, b float64) {
+	if s == 0 {
+		return l, l, l
+	}
+
+	var m2 float64
+	if l <= 0.5 {
+		m2 = l * (1 + s)
+	} else {
+		m2 = l + s - l*s
+	}
+	m1 := 2*l - m2
+	return hueToRGB(m1, m2, h+1.0/3), hueToRGB(m1, m2, h), hueToRGB(m1, m2, h-1.0/3)
+}
+
+func hueToRGB(m1, m2, h float64) float64 {
+	h -= math.Floor(h)
+	switch {
+	case h < 1.0/6:
+		return m1 + (m2-m1)*h*6
+	case h < 0.5:
+		return m2
+	case h < 2.0/3:
+		return m1 + (m2-m1)*(2.0/3-h)*6
+	}
+	return m1
+}
+
+// ResolveColor returns the ARGB value of a color reference using the theme
+// and the indexed palette of the workbook. Automatic colors and references
+// missing from the theme or palette can't be resolved.
+func (x *Xlsx) ResolveColor(c Color) (string, bool) {
+	x.colors.resolve(&c)
+	return c.ARGB, c.ARGB != ""
+}
+
+// ThemeError returns the error of reading the workbook theme. The workbook
+// opens without theme colors when the theme part is broken, ResolveColor
+// can't resolve theme references then.
+func (x *Xlsx) ThemeError() error {
+	return x.colors.themeErr
+}
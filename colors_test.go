@@ -0,0 +1,98 @@
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testTheme = `<?xml version="1.0" encoding="UTF-8"?>
+<a:theme xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" name="Office Theme"><a:themeElements><a:clrScheme name="Office">` +
+	`<a:dk1><a:sysClr val="windowText" lastClr="000000"/></a:dk1>` +
+	`<a:lt1><a:sysClr val="window" lastClr="FFFFFF"/></a:lt1>` +
+	`<a:dk2><a:srgbClr val="44546A"/></a:dk2>` +
+	`<a:lt2><a:srgbClr val="E7E6E6"/></a:lt2>` +
+	`<a:accent1><a:srgbClr val="4472C4"/></a:accent1>` +
+	`<a:accent2><a:srgbClr val="ED7D31"/></a:accent2>` +
+	`</a:clrScheme></a:themeElements></a:theme>`
+
+func TestResolveColor(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/theme" Target="theme/theme1.xml"/>` +
+			`<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+			`</Relationships>`,
+		"xl/theme/theme1.xml": testTheme,
+		"xl/styles.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<fonts count="2"><font><sz val="11"/><color theme="1"/><name val="Calibri"/></font><font><color indexed="2"/></font></fonts>` +
+			`<fills count="1"><fill><patternFill patternType="solid"><fgColor theme="4" tint="-0.249977111117893"/></patternFill></fill></fills>` +
+			`<borders count="1"><border/></borders>` +
+			`<cellXfs count="2"><xf fontId="0" fillId="0" borderId="0"/><xf fontId="1" fillId="0" borderId="0"/></cellXfs>` +
+			`<colors><indexedColors><rgbColor rgb="FF000000"/><rgbColor rgb="FFFFFFFF"/><rgbColor rgb="FF123456"/></indexedColors></colors>` +
+			`</styleSheet>`,
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData><row r="1"><c r="A1" s="0"><v>1</v></c><c r="B1" s="1"><v>2</v></c></row></sheetData>`),
+	})
+
+	require.NoError(t, xlsx.ThemeError())
+
+	tests := []struct {
+		name  string
+		color Color
+		argb  string
+		ok    bool
+	}{
+		{"rgb", Color{Kind: ColorRGB, RGB: "FF00FF00"}, "FF00FF00", true},
+		{"theme dark", Color{Kind: ColorTheme, Theme: 1}, "FF000000", true},
+		{"theme light", Color{Kind: ColorTheme, Theme: 0}, "FFFFFFFF", true},
+		{"theme darker", Color{Kind: ColorTheme, Theme: 4, Tint: -0.249977111117893}, "FF2F5597", true},
+		{"theme lighter", Color{Kind: ColorTheme, Theme: 4, Tint: 0.3999755851924192}, "FF8FAADC", true},
+		{"theme missing", Color{Kind: ColorTheme, Theme: 7}, "", false},
+		{"indexed override", Color{Kind: ColorIndexed, Indexed: 2}, "FF123456", true},
+		{"indexed legacy", Color{Kind: ColorIndexed, Indexed: 10}, "FFFF0000", true},
+		{"indexed system", Color{Kind: ColorIndexed, Indexed: 64}, "FF000000", true},
+		{"auto", Color{Kind: ColorAuto}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			argb, ok := xlsx.ResolveColor(tt.color)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.argb, argb)
+		})
+	}
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextCell())
+	style := sheet.CellStyle()
+	require.Equal(t, "FF000000", style.Font.Color.ARGB)
+	require.Equal(t, "FF2F5597", style.Fill.FgColor.ARGB)
+
+	require.True(t, sheet.NextCell())
+	require.Equal(t, "FF123456", sheet.CellStyle().Font.Color.ARGB)
+}
+
+func TestBrokenTheme(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/theme" Target="theme/theme1.xml"/>` +
+			`</Relationships>`,
+		"xl/theme/theme1.xml":      `<a:theme xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main"><a:themeElements>`,
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData/>`),
+	})
+
+	require.Error(t, xlsx.ThemeError())
+
+	_, ok := xlsx.ResolveColor(Color{Kind: ColorTheme, Theme: 1})
+	require.False(t, ok)
+	argb, ok := xlsx.ResolveColor(Color{Kind: ColorIndexed, Indexed: 2})
+	require.True(t, ok)
+	require.Equal(t, "FFFF0000", argb)
+}
@@ -0,0 +1,220 @@
+package xlsx
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/anfilat/xlsx-sax/internal/xml"
+)
+
+// Comment is a legacy cell note. Runs is set for notes with formatted text.
+type Comment struct {
+	Ref    string
+	Row    int
+	Col    int
+	Author string
+	Text   string
+	Runs   []RichTextRun
+}
+
+type commentIndex struct {
+	comments []Comment
+	cells    map[int]int
+}
+
+func readComments(reader io.Reader) ([]Comment, error) {
+	decoder := xml.NewDecoder(reader, append(fontTagAttrs(), xml.TagAttrs{
+		Name: "comment",
+		Attr: []xml.TagAttr{
+			{Name: "ref"},
+			{Name: "authorId"},
+		},
+	}))
+
+	var (
+		result   []Comment
+		authors  []string
+		authorID []int
+		comment  *Comment
+		run      *RichTextRun
+		text     strings.Builder
+		isAuthor bool
+		isT      bool
+	)
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		switch token := t.(type) {
+		case *xml.StartElement:
+			switch token.Name.Local {
+			case "author":
+				isAuthor = true
+				text.Reset()
+			case "comment":
+				result = append(result, Comment{})
+				comment = &result[len(result)-1]
+				text.Reset()
+				id := -1
+				for _, a := range token.Attr {
+					switch a.Name.Local {
+					case "ref":
+						comment.Ref = a.Value.String()
+						col, row, ok := parseCellRef(a.Value.Bytes())
+						if !ok {
+							return nil, ErrIncorrectComments
+						}
+						comment.Row = row
+						comment.Col = col
+					case "authorId":
+						id, err = strconv.Atoi(a.Value.String())
+						if err != nil {
+							return nil, ErrIncorrectComments
+						}
+					}
+				}
+				authorID = append(authorID, id)
+			case "r":
+				if comment != nil {
+					comment.Runs = append(comment.Runs, RichTextRun{})
+					run = &comment.Runs[len(comment.Runs)-1]
+				}
+			case "rPr":
+				font, er := readFont(decoder)
+				if er != nil {
+					return nil, er
+				}
+				if run != nil {
+					run.Font = font
+				}
+			case "t":
+				isT = true
+			case "rPh", "phoneticPr":
+				if er := decoder.Skip(); er != nil {
+					return nil, er
+				}
+			}
+		case *xml.EndElement:
+			switch token.Name.Local {
+			case "author":
+				isAuthor = false
+				authors = append(authors, text.String())
+			case "comment":
+				if comment != nil {
+					comment.Text = text.String()
+				}
+				comment = nil
+			case "r":
+				run = nil
+			case "t":
+				isT = false
+			}
+		case *xml.CharData:
+			if isAuthor || (isT && comment != nil) {
+				text.Write(token.Value)
+			}
+			if isT && run != nil {
+				run.Text += string(token.Value)
+			}
+		}
+	}
+
+	for i := range result {
+		if id := authorID[i]; id >= 0 && id < len(authors) {
+			result[i].Author = authors[id]
+		}
+	}
+	return result, nil
+}
+
+func (s *Sheet) loadComments() (*commentIndex, error) {
+	if s.comments != nil {
+		return s.comments, nil
+	}
+
+	index := &commentIndex{
+		cells: make(map[int]int),
+	}
+
+	rels, err := s.x.partRels(s.file.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, rel := range sortedRels(rels) {
+		if rel.Type != relTypeComments {
+			continue
+		}
+
+		zipFile, ok := s.x.files[resolveTarget(s.file.Name, rel.Target)]
+		if !ok {
+			continue
+		}
+
+		reader, er := zipFile.Open()
+		if er != nil {
+			return nil, er
+		}
+		comments, er := readComments(reader)
+		_ = reader.Close()
+		if er != nil {
+			return nil, er
+		}
+		index.comments = append(index.comments, comments...)
+	}
+
+	for i, c := range index.comments {
+		index.cells[c.Row*maxColumns+c.Col] = i
+		for _, run := range c.Runs {
+			if run.Font != nil {
+				s.x.colors.resolve(&run.Font.Color)
+			}
+		}
+	}
+
+	s.comments = index
+	return index, nil
+}
+
+// Comments returns the notes of the sheet.
+func (s *Sheet) Comments() ([]Comment, error) {
+	index, err := s.loadComments()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Comment, len(index.comments))
+	for i, c := range index.comments {
+		result[i] = copyComment(c)
+	}
+	return result, nil
+}
+
+// CellComment returns the note of the current cell or nil.
+func (s *Sheet) CellComment() (*Comment, error) {
+	index, err := s.loadComments()
+	if err != nil {
+		return nil, err
+	}
+
+	i, ok := index.cells[s.Row*maxColumns+s.Col]
+	if !ok {
+		return nil, nil
+	}
+	c := copyComment(index.comments[i])
+	return &c, nil
+}
+
+// copyComment returns a comment that shares no runs or fonts with the cached
+// one.
+func copyComment(c Comment) Comment {
+	if c.Runs != nil {
+		c.Runs = copyRuns(c.Runs)
+	}
+	return c
+}
@@ -0,0 +1,80 @@
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComments(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="A1"><v>1</v></c><c r="B1"><v>2</v></c></row>` +
+			`</sheetData>`),
+		"xl/worksheets/_rels/sheet1.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/comments" Target="../comments1.xml"/></Relationships>`,
+		"xl/comments1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<comments xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><authors><author>Ann</author><author>Bob</author></authors><commentList>` +
+			`<comment ref="B1" authorId="1"><text><r><rPr><b/><sz val="9"/><color indexed="81"/><rFont val="Tahoma"/><family val="2"/></rPr><t>Bob:</t></r><r><rPr><sz val="9"/><rFont val="Tahoma"/></rPr><t xml:space="preserve"> check</t></r></text></comment>` +
+			`<comment ref="A1" authorId="0"><text><t>plain</t></text></comment>` +
+			`</commentList></comments>`,
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	comments, err := sheet.Comments()
+	require.NoError(t, err)
+	require.Equal(t, []Comment{
+		{
+			Ref: "B1", Row: 0, Col: 1, Author: "Bob", Text: "Bob: check",
+			Runs: []RichTextRun{
+				{Text: "Bob:", Font: &Font{Name: "Tahoma", Size: 9, Bold: true, Family: 2, Color: Color{Kind: ColorIndexed, Indexed: 81}}},
+				{Text: " check", Font: &Font{Name: "Tahoma", Size: 9}},
+			},
+		},
+		{Ref: "A1", Row: 0, Col: 0, Author: "Ann", Text: "plain"},
+	}, comments)
+
+	comments[0].Runs[0].Text = "changed"
+	comments[0].Runs[0].Font.Bold = false
+	again, err := sheet.Comments()
+	require.NoError(t, err)
+	require.Equal(t, "Bob:", again[0].Runs[0].Text)
+	require.True(t, again[0].Runs[0].Font.Bold)
+
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextCell())
+	c, err := sheet.CellComment()
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	require.Equal(t, "plain", c.Text)
+
+	require.True(t, sheet.NextCell())
+	c, err = sheet.CellComment()
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	require.Equal(t, "Bob", c.Author)
+
+	c.Runs[0].Font.Size = 20
+	c, err = sheet.CellComment()
+	require.NoError(t, err)
+	require.Equal(t, 9.0, c.Runs[0].Font.Size)
+}
+
+func TestNoComments(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData><row r="1"><c r="A1"><v>1</v></c></row></sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextCell())
+	c, err := sheet.CellComment()
+	require.NoError(t, err)
+	require.Nil(t, c)
+}
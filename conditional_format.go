@@ -0,0 +1,163 @@
+package xlsx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/anfilat/xlsx-sax/internal/xml"
+)
+
+// ConditionalFormat is a conditionalFormatting element of a sheet, the rules
+// apply to all Ranges.
+type ConditionalFormat struct {
+	Ranges []Range
+	Rules  []ConditionalRule
+}
+
+// ConditionalRule is a conditional formatting rule. Type is the rule type
+// like cellIs, expression or colorScale, DxfID is the index of its
+// differential format or -1 when the rule has none. Formulas hold the rule
+// formulas without the leading equal sign.
+type ConditionalRule struct {
+	Type       string
+	Priority   int
+	DxfID      int
+	Operator   string
+	Text       string
+	StopIfTrue bool
+	Formulas   []string
+}
+
+func conditionalFormatTagAttrs() []xml.TagAttrs {
+	return []xml.TagAttrs{
+		{
+			Name: "conditionalFormatting",
+			Attr: []xml.TagAttr{
+				{Name: "sqref"},
+			},
+		},
+		{
+			Name: "cfRule",
+			Attr: []xml.TagAttr{
+				{Name: "type"},
+				{Name: "priority"},
+				{Name: "dxfId"},
+				{Name: "operator"},
+				{Name: "text"},
+				{Name: "stopIfTrue"},
+			},
+		},
+	}
+}
+
+// readConditionalFormat reads a conditionalFormatting element including its
+// end element.
+func readConditionalFormat(decoder *xml.Decoder, start *xml.StartElement) (ConditionalFormat, error) {
+	var f ConditionalFormat
+	for _, a := range start.Attr {
+		if a.Name.Local == "sqref" {
+			ranges, err := parseSqref(a.Value.String())
+			if err != nil {
+				return f, err
+			}
+			f.Ranges = ranges
+		}
+	}
+
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			return f, err
+		}
+
+		switch token := t.(type) {
+		case *xml.StartElement:
+			if token.Name.Local != "cfRule" {
+				if er := decoder.Skip(); er != nil {
+					return f, er
+				}
+				continue
+			}
+			rule, er := readConditionalRule(decoder, token)
+			if er != nil {
+				return f, er
+			}
+			f.Rules = append(f.Rules, rule)
+		case *xml.EndElement:
+			return f, nil
+		}
+	}
+}
+
+func readConditionalRule(decoder *xml.Decoder, start *xml.StartElement) (ConditionalRule, error) {
+	rule := ConditionalRule{DxfID: -1}
+
+	var err error
+	for _, a := range start.Attr {
+		switch a.Name.Local {
+		case "type":
+			rule.Type = a.Value.String()
+		case "priority":
+			rule.Priority, err = strconv.Atoi(a.Value.String())
+		case "dxfId":
+			rule.DxfID, err = strconv.Atoi(a.Value.String())
+		case "operator":
+			rule.Operator = a.Value.String()
+		case "text":
+			rule.Text = a.Value.String()
+		case "stopIfTrue":
+			rule.StopIfTrue = parseBoolAttr(a.Value.Bytes())
+		}
+		if err != nil {
+			return rule, fmt.Errorf("can not parse %s of cfRule: %w", a.Name.Local, ErrIncorrectSheet)
+		}
+	}
+
+	var (
+		text    strings.Builder
+		formula bool
+		depth   int
+	)
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			return rule, err
+		}
+
+		switch token := t.(type) {
+		case *xml.StartElement:
+			depth++
+			if depth == 1 && token.Name.Local == "formula" {
+				formula = true
+				text.Reset()
+			}
+		case *xml.EndElement:
+			if depth == 0 {
+				return rule, nil
+			}
+			depth--
+			if depth == 0 && formula {
+				rule.Formulas = append(rule.Formulas, strings.TrimPrefix(text.String(), "="))
+				formula = false
+			}
+		case *xml.CharData:
+			if formula {
+				text.Write(token.Value)
+			}
+		}
+	}
+}
+
+// ConditionalFormats returns the conditional formatting of the sheet. The
+// differential formats of the rules are available via Xlsx.DifferentialFormat.
+func (s *Sheet) ConditionalFormats() ([]ConditionalFormat, error) {
+	tail, err := s.loadTail()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ConditionalFormat, len(tail.conditionalFormats))
+	copy(result, tail.conditionalFormats)
+	return result, nil
+}
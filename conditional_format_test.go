@@ -0,0 +1,57 @@
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionalFormats(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData><row r="1"><c r="A1"><v>1</v></c></row></sheetData>` +
+			`<conditionalFormatting sqref="A1:A10 C1">` +
+			`<cfRule type="cellIs" dxfId="0" priority="2" operator="between" stopIfTrue="1"><formula>1</formula><formula>=$B$1</formula></cfRule>` +
+			`<cfRule type="colorScale" priority="1"><colorScale><cfvo type="min"/><cfvo type="max"/><color rgb="FFF8696B"/><color rgb="FF63BE7B"/></colorScale></cfRule>` +
+			`</conditionalFormatting>` +
+			`<conditionalFormatting sqref="B1:B5"><cfRule type="containsText" dxfId="1" priority="3" operator="containsText" text="x"><formula>NOT(ISERROR(SEARCH("x",B1)))</formula></cfRule></conditionalFormatting>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	formats, err := sheet.ConditionalFormats()
+	require.NoError(t, err)
+	require.Equal(t, []ConditionalFormat{
+		{
+			Ranges: []Range{
+				{FirstRow: 0, FirstCol: 0, LastRow: 9, LastCol: 0},
+				{FirstRow: 0, FirstCol: 2, LastRow: 0, LastCol: 2},
+			},
+			Rules: []ConditionalRule{
+				{Type: "cellIs", Priority: 2, DxfID: 0, Operator: "between", StopIfTrue: true, Formulas: []string{"1", "$B$1"}},
+				{Type: "colorScale", Priority: 1, DxfID: -1},
+			},
+		},
+		{
+			Ranges: []Range{{FirstRow: 0, FirstCol: 1, LastRow: 4, LastCol: 1}},
+			Rules: []ConditionalRule{
+				{Type: "containsText", Priority: 3, DxfID: 1, Operator: "containsText", Text: "x", Formulas: []string{`NOT(ISERROR(SEARCH("x",B1)))`}},
+			},
+		},
+	}, formats)
+}
+
+func TestConditionalFormatsIncorrect(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData/>` +
+			`<conditionalFormatting sqref="A1"><cfRule type="cellIs" priority="x"/></conditionalFormatting>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	_, err = sheet.ConditionalFormats()
+	require.ErrorIs(t, err, ErrIncorrectSheet)
+}
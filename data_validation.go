@@ -0,0 +1,248 @@
+package xlsx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anfilat/xlsx-sax/internal/xml"
+)
+
+// maxNameDepth limits defined names referring to other defined names.
+const maxNameDepth = 8
+
+// DataValidation is a data validation rule of a sheet. Type is one of none,
+// whole, decimal, list, date, time, textLength or custom. Formula1 and
+// Formula2 hold the rule values without the leading equal sign, for list
+// rules Formula1 is the list source. HideDropDown mirrors the showDropDown
+// attribute, which hides the in-cell dropdown when set.
+type DataValidation struct {
+	Type             string
+	Operator         string
+	ErrorStyle       string
+	Formula1         string
+	Formula2         string
+	Ranges           []Range
+	AllowBlank       bool
+	HideDropDown     bool
+	ShowInputMessage bool
+	ShowErrorMessage bool
+	ErrorTitle       string
+	Error            string
+	PromptTitle      string
+	Prompt           string
+}
+
+// readDataValidation reads a dataValidation element, both the worksheet one
+// and the x14 one from the extension list, which keeps formulas and ranges in
+// child elements.
+func readDataValidation(decoder *xml.Decoder, start *xml.StartElement) (DataValidation, error) {
+	v := DataValidation{
+		Type:       "none",
+		Operator:   "between",
+		ErrorStyle: "stop",
+	}
+
+	for _, a := range start.Attr {
+		switch a.Name.Local {
+		case "type":
+			v.Type = a.Value.String()
+		case "operator":
+			v.Operator = a.Value.String()
+		case "errorStyle":
+			v.ErrorStyle = a.Value.String()
+		case "allowBlank":
+			v.AllowBlank = parseBoolAttr(a.Value.Bytes())
+		case "showDropDown":
+			v.HideDropDown = parseBoolAttr(a.Value.Bytes())
+		case "showInputMessage":
+			v.ShowInputMessage = parseBoolAttr(a.Value.Bytes())
+		case "showErrorMessage":
+			v.ShowErrorMessage = parseBoolAttr(a.Value.Bytes())
+		case "errorTitle":
+			v.ErrorTitle = a.Value.String()
+		case "error":
+			v.Error = a.Value.String()
+		case "promptTitle":
+			v.PromptTitle = a.Value.String()
+		case "prompt":
+			v.Prompt = a.Value.String()
+		case "sqref":
+			ranges, err := parseSqref(a.Value.String())
+			if err != nil {
+				return v, err
+			}
+			v.Ranges = ranges
+		}
+	}
+
+	var (
+		text    strings.Builder
+		current string
+		depth   int
+	)
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			return v, err
+		}
+
+		switch token := t.(type) {
+		case *xml.StartElement:
+			depth++
+			if depth == 1 {
+				current = token.Name.Local
+				text.Reset()
+			}
+		case *xml.EndElement:
+			if depth == 0 {
+				return v, nil
+			}
+			depth--
+			if depth > 0 {
+				break
+			}
+			switch current {
+			case "formula1":
+				v.Formula1 = strings.TrimPrefix(text.String(), "=")
+			case "formula2":
+				v.Formula2 = strings.TrimPrefix(text.String(), "=")
+			case "sqref":
+				ranges, er := parseSqref(text.String())
+				if er != nil {
+					return v, er
+				}
+				v.Ranges = ranges
+			}
+			current = ""
+		case *xml.CharData:
+			if current != "" {
+				text.Write(token.Value)
+			}
+		}
+	}
+}
+
+// parseSqref parses a space separated list of references.
+func parseSqref(sqref string) ([]Range, error) {
+	fields := strings.Fields(sqref)
+	result := make([]Range, 0, len(fields))
+	for _, ref := range fields {
+		r, err := ParseRange(ref)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// DataValidations returns the data validation rules of the sheet.
+func (s *Sheet) DataValidations() ([]DataValidation, error) {
+	tail, err := s.loadTail()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]DataValidation, len(tail.validations))
+	copy(result, tail.validations)
+	return result, nil
+}
+
+// CellDataValidation returns the data validation rule of the current cell or nil.
+func (s *Sheet) CellDataValidation() (*DataValidation, error) {
+	tail, err := s.loadTail()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range tail.validations {
+		for _, r := range v.Ranges {
+			if r.Contains(s.Row, s.Col) {
+				return &v, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// DataValidationList returns the allowed values of a list rule. The list
+// source may be a literal list, a range or a defined name referring to a range.
+// Empty cells of a source range are left out.
+func (s *Sheet) DataValidationList(v DataValidation) ([]string, error) {
+	if v.Type != "list" {
+		return nil, fmt.Errorf("can not get list of %s rule: %w", v.Type, ErrInvalidListSource)
+	}
+	return s.resolveList(v.Formula1, 0)
+}
+
+func (s *Sheet) resolveList(source string, depth int) ([]string, error) {
+	source = strings.TrimSpace(strings.TrimPrefix(source, "="))
+
+	if strings.HasPrefix(source, `"`) {
+		if len(source) < 2 || !strings.HasSuffix(source, `"`) {
+			return nil, fmt.Errorf("can not parse list %s: %w", source, ErrNoClosingQuote)
+		}
+		list := strings.ReplaceAll(source[1:len(source)-1], `""`, `"`)
+		return strings.Split(list, ","), nil
+	}
+
+	sheet, cell := splitLocation(source)
+	if cell != "" {
+		r, err := ParseRange(cell)
+		if err != nil {
+			return nil, err
+		}
+		if sheet == "" {
+			sheet = s.name
+		}
+		return s.x.rangeValues(sheet, r)
+	}
+
+	if depth < maxNameDepth {
+		if value, ok := s.x.findDefinedName(source, s.name); ok {
+			return s.resolveList(value, depth+1)
+		}
+	}
+
+	return nil, fmt.Errorf("can not resolve list source %s: %w", source, ErrInvalidListSource)
+}
+
+// rangeValues returns the formatted values of the non-empty cells of a range
+// in row order.
+func (x *Xlsx) rangeValues(sheetName string, r Range) ([]string, error) {
+	sheet, err := x.OpenSheetByName(sheetName)
+	if err != nil {
+		return nil, err
+	}
+	defer sheet.Close()
+
+	var result []string
+	for sheet.NextRow() {
+		if sheet.Row > r.LastRow {
+			break
+		}
+		if sheet.Row < r.FirstRow {
+			continue
+		}
+
+		for sheet.NextCell() {
+			if sheet.Col < r.FirstCol || sheet.Col > r.LastCol {
+				continue
+			}
+			value, er := sheet.CellFormatValue()
+			if er != nil {
+				return nil, er
+			}
+			if value != "" {
+				result = append(result, value)
+			}
+		}
+	}
+
+	if err = sheet.Err(); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,89 @@
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataValidations(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/><sheet name="Lists" sheetId="2" r:id="rId2"/></sheets>` +
+			`<definedNames><definedName name="Colors">Lists!$B$1:$B$3</definedName><definedName name="Alias">Colors</definedName></definedNames></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/><Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet2.xml"/></Relationships>`,
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="A1"><v>1</v></c><c r="B1"><v>2</v></c></row>` +
+			`</sheetData>` +
+			`<dataValidations count="3">` +
+			`<dataValidation type="list" allowBlank="1" showInputMessage="1" showErrorMessage="1" errorTitle="Oops" error="Pick one" sqref="A1:A10 C1"><formula1>"Yes,No,""Maybe"""</formula1></dataValidation>` +
+			`<dataValidation type="whole" operator="greaterThan" errorStyle="warning" sqref="D1"><formula1>0</formula1></dataValidation>` +
+			`<dataValidation type="list" sqref="E1"><formula1>Alias</formula1></dataValidation>` +
+			`</dataValidations>` +
+			`<extLst><ext uri="{CCE6A557-97BC-4b89-ADB6-D9C93CAAB3DF}" xmlns:x14="http://schemas.microsoft.com/office/spreadsheetml/2009/9/main" xmlns:xm="http://schemas.microsoft.com/office/excel/2006/main">` +
+			`<x14:dataValidations count="1"><x14:dataValidation type="list" showDropDown="1" prompt="From sheet"><x14:formula1><xm:f>Lists!$A$1:$A$4</xm:f></x14:formula1><xm:sqref>B1:B5</xm:sqref></x14:dataValidation></x14:dataValidations>` +
+			`</ext></extLst>`),
+		"xl/worksheets/sheet2.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="A1" t="inlineStr"><is><t>red</t></is></c><c r="B1" t="inlineStr"><is><t>small</t></is></c></row>` +
+			`<row r="2"><c r="A2" t="inlineStr"><is><t>green</t></is></c><c r="B2" t="inlineStr"><is><t>large</t></is></c></row>` +
+			`<row r="4"><c r="A4"><v>7</v></c></row>` +
+			`<row r="5"><c r="A5" t="inlineStr"><is><t>skipped</t></is></c></row>` +
+			`</sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByName("Sheet1")
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	validations, err := sheet.DataValidations()
+	require.NoError(t, err)
+	require.Equal(t, []DataValidation{
+		{
+			Type: "list", Operator: "between", ErrorStyle: "stop", Formula1: `"Yes,No,""Maybe"""`,
+			Ranges:     []Range{{FirstRow: 0, FirstCol: 0, LastRow: 9, LastCol: 0}, {FirstRow: 0, FirstCol: 2, LastRow: 0, LastCol: 2}},
+			AllowBlank: true, ShowInputMessage: true, ShowErrorMessage: true, ErrorTitle: "Oops", Error: "Pick one",
+		},
+		{
+			Type: "whole", Operator: "greaterThan", ErrorStyle: "warning", Formula1: "0",
+			Ranges: []Range{{FirstRow: 0, FirstCol: 3, LastRow: 0, LastCol: 3}},
+		},
+		{
+			Type: "list", Operator: "between", ErrorStyle: "stop", Formula1: "Alias",
+			Ranges: []Range{{FirstRow: 0, FirstCol: 4, LastRow: 0, LastCol: 4}},
+		},
+		{
+			Type: "list", Operator: "between", ErrorStyle: "stop", Formula1: "Lists!$A$1:$A$4",
+			Ranges:       []Range{{FirstRow: 0, FirstCol: 1, LastRow: 4, LastCol: 1}},
+			HideDropDown: true, Prompt: "From sheet",
+		},
+	}, validations)
+
+	list, err := sheet.DataValidationList(validations[0])
+	require.NoError(t, err)
+	require.Equal(t, []string{"Yes", "No", `"Maybe"`}, list)
+
+	list, err = sheet.DataValidationList(validations[2])
+	require.NoError(t, err)
+	require.Equal(t, []string{"small", "large"}, list)
+
+	list, err = sheet.DataValidationList(validations[3])
+	require.NoError(t, err)
+	require.Equal(t, []string{"red", "green", "7"}, list)
+
+	_, err = sheet.DataValidationList(validations[1])
+	require.ErrorIs(t, err, ErrInvalidListSource)
+
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextCell())
+	v, err := sheet.CellDataValidation()
+	require.NoError(t, err)
+	require.NotNil(t, v)
+	require.Equal(t, "Oops", v.ErrorTitle)
+
+	require.True(t, sheet.NextCell())
+	v, err = sheet.CellDataValidation()
+	require.NoError(t, err)
+	require.NotNil(t, v)
+	require.Equal(t, "From sheet", v.Prompt)
+}
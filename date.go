@@ -2,20 +2,132 @@ package xlsx
 
 // Most of this file was taken from https://github.com/tealeg/xlsx
 
-import "time"
+import (
+	"math"
+	"strings"
+	"time"
+)
 
-const nanosInADay = float64((24 * time.Hour) / time.Nanosecond)
+const (
+	msInADay = int64(24 * time.Hour / time.Millisecond)
+	// maxExcelSerial is 10000-01-01, the first day Excel can't show.
+	maxExcelSerial = 2958466
+)
 
 var (
 	excel1900Epoc = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
-	excel1904Epoc = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
+	// excel1900BugEpoc is the epoch of the serials before the nonexistent
+	// February 29 1900, which Excel counts as serial 60.
+	excel1900BugEpoc = time.Date(1899, time.December, 31, 0, 0, 0, 0, time.UTC)
+	excel1900LeapDay = time.Date(1900, time.March, 1, 0, 0, 0, 0, time.UTC)
+	excel1904Epoc    = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
 )
 
+// timeFromExcelTime converts a serial date to time. The serial is rounded to
+// milliseconds, the precision Excel shows. In the 1900 date system serials
+// before 61 are shifted by the leap year bug, serial 60 is February 28 and
+// time only values are on December 31 1899. Negative serials count back from
+// the epoch with a positive time of day.
 func timeFromExcelTime(excelTime float64, date1904 bool) time.Time {
-	wholeDaysPart := int(excelTime)
-	durationPart := time.Duration(nanosInADay * (excelTime - float64(wholeDaysPart)))
-	if date1904 {
-		return excel1904Epoc.AddDate(0, 0, wholeDaysPart).Add(durationPart)
+	ms := int64(math.Round(excelTime * float64(msInADay)))
+	days := ms / msInADay
+	ms %= msInADay
+	if ms < 0 {
+		days--
+		ms += msInADay
+	}
+
+	epoc := excel1900Epoc
+	switch {
+	case date1904:
+		epoc = excel1904Epoc
+	case days == 60:
+		epoc = excel1900BugEpoc
+		days = 59
+	case days < 60:
+		epoc = excel1900BugEpoc
+	}
+	return epoc.AddDate(0, 0, int(days)).Add(time.Duration(ms) * time.Millisecond)
+}
+
+// validExcelTime reports whether a serial date is within the range of dates
+// Excel supports, negative serials are allowed.
+func validExcelTime(excelTime float64) bool {
+	return !math.IsNaN(excelTime) && math.Abs(excelTime) < maxExcelSerial
+}
+
+// durationFromExcelTime converts a serial number of days to a duration
+// rounded to milliseconds.
+func durationFromExcelTime(excelTime float64) (time.Duration, error) {
+	ms := math.Round(excelTime * float64(msInADay))
+	if math.IsNaN(ms) || math.Abs(ms) > float64(math.MaxInt64/int64(time.Millisecond)) {
+		return 0, ErrTimeOutOfRange
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// isoTimeLayouts are the ISO 8601 layouts of t="d" cells. Fractional seconds
+// are accepted by time.Parse without being in the layout.
+var isoTimeLayouts = []string{
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+var isoClockLayouts = []string{
+	"15:04:05Z07:00",
+	"15:04:05",
+	"15:04Z07:00",
+	"15:04",
+}
+
+func parseISOTime(value string) (time.Time, error) {
+	return parseISODate(value, false)
+}
+
+// parseISODate parses the value of a t="d" cell, which is a date, a date with
+// time or a time, optionally with fractional seconds and an offset. A time
+// without date is on the day of serial 0 of the date system. An offset is
+// kept as the location of the result.
+func parseISODate(value string, date1904 bool) (t time.Time, err error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range isoTimeLayouts {
+		t, err = time.Parse(layout, value)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	clock := strings.TrimPrefix(value, "T")
+	for _, layout := range isoClockLayouts {
+		c, er := time.Parse(layout, clock)
+		if er == nil {
+			day := timeFromExcelTime(0, date1904)
+			return time.Date(day.Year(), day.Month(), day.Day(), c.Hour(), c.Minute(), c.Second(), c.Nanosecond(), c.Location()), nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// wallTime returns the wall clock time of t in loc.
+func wallTime(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// excelTimeFromTime converts the wall clock time of t to a serial date, it is
+// the inverse of timeFromExcelTime.
+func excelTimeFromTime(t time.Time, date1904 bool) float64 {
+	wall := wallTime(t, time.UTC)
+
+	epoc := excel1900Epoc
+	switch {
+	case date1904:
+		epoc = excel1904Epoc
+	case wall.Before(excel1900LeapDay):
+		epoc = excel1900BugEpoc
 	}
-	return excel1900Epoc.AddDate(0, 0, wholeDaysPart).Add(durationPart)
+	seconds := wall.Unix() - epoc.Unix()
+	return (float64(seconds) + float64(wall.Nanosecond())/float64(time.Second)) / (24 * 60 * 60)
 }
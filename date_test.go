@@ -0,0 +1,163 @@
+package xlsx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeFromExcelTime(t *testing.T) {
+	tests := []struct {
+		serial   float64
+		date1904 bool
+		want     time.Time
+	}{
+		{0, false, time.Date(1899, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{0.5, false, time.Date(1899, time.December, 31, 12, 0, 0, 0, time.UTC)},
+		{1, false, time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{59, false, time.Date(1900, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{60, false, time.Date(1900, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{60.25, false, time.Date(1900, time.February, 28, 6, 0, 0, 0, time.UTC)},
+		{61, false, time.Date(1900, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{45352.5, false, time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)},
+		{0.9999999, false, time.Date(1899, time.December, 31, 23, 59, 59, 991000000, time.UTC)},
+		{0.999999999, false, time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{45352.123456789, false, time.Date(2024, time.March, 1, 2, 57, 46, 667000000, time.UTC)},
+		{2958465.9999999, false, time.Date(9999, time.December, 31, 23, 59, 59, 991000000, time.UTC)},
+		{0, true, time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{-1.25, true, time.Date(1903, time.December, 30, 18, 0, 0, 0, time.UTC)},
+		{-0.5, true, time.Date(1903, time.December, 31, 12, 0, 0, 0, time.UTC)},
+		{-1.25, false, time.Date(1899, time.December, 29, 18, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, timeFromExcelTime(tt.serial, tt.date1904), "serial %v", tt.serial)
+	}
+}
+
+func TestCellTimeAndDuration(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData><row r="1">` +
+			`<c r="A1"><v>45352.75</v></c>` +
+			`<c r="B1"><v>1.5000000001</v></c>` +
+			`<c r="C1"><v>1E+300</v></c>` +
+			`</row></sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	loc := time.FixedZone("UTC+3", 3*60*60)
+
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextCell())
+	v, err := sheet.CellTime()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, time.March, 1, 18, 0, 0, 0, time.UTC), v)
+	v, err = sheet.CellTimeIn(loc)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, time.March, 1, 18, 0, 0, 0, loc), v)
+
+	require.True(t, sheet.NextCell())
+	d, err := sheet.CellDuration()
+	require.NoError(t, err)
+	require.Equal(t, 36*time.Hour, d)
+
+	require.True(t, sheet.NextCell())
+	_, err = sheet.CellTime()
+	require.ErrorIs(t, err, ErrTimeOutOfRange)
+	_, err = sheet.CellDuration()
+	require.ErrorIs(t, err, ErrTimeOutOfRange)
+}
+
+func TestISODateCells(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/styles.xml": testStyles,
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData><row r="1">` +
+			`<c r="A1" t="d" s="1"><v>2024-03-01</v></c>` +
+			`<c r="B1" t="d" s="2"><v>2024-03-01T10:30:15.250</v></c>` +
+			`<c r="C1" t="d"><v>12:00:00</v></c>` +
+			`<c r="D1" t="d" s="2"><v>2024-03-01T10:00:00+03:00</v></c>` +
+			`<c r="E1" t="d" s="1"><v>1900-01-01</v></c>` +
+			`<c r="F1" t="d"><v>2024-03-05T10:30:00</v></c>` +
+			`</row></sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	loc := time.FixedZone("UTC-5", -5*60*60)
+
+	require.True(t, sheet.NextRow())
+
+	require.True(t, sheet.NextCell())
+	f, err := sheet.CellFloat()
+	require.NoError(t, err)
+	require.Equal(t, 45352.0, f)
+	n, err := sheet.CellInt()
+	require.NoError(t, err)
+	require.Equal(t, 45352, n)
+	s, err := sheet.CellFormatValue()
+	require.NoError(t, err)
+	require.Equal(t, "03-01-24", s)
+
+	require.True(t, sheet.NextCell())
+	v, err := sheet.CellTime()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, time.March, 1, 10, 30, 15, 250000000, time.UTC), v)
+	v, err = sheet.CellTimeIn(loc)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, time.March, 1, 10, 30, 15, 250000000, loc), v)
+	v, err = sheet.CellTimeIn(nil)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, time.March, 1, 10, 30, 15, 250000000, time.UTC), v)
+	s, err = sheet.CellFormatValue()
+	require.NoError(t, err)
+	require.Equal(t, "2024-03-01 10:30", s)
+
+	require.True(t, sheet.NextCell())
+	value, err := sheet.Value()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(1899, time.December, 31, 12, 0, 0, 0, time.UTC), value)
+	f, err = sheet.CellFloat()
+	require.NoError(t, err)
+	require.Equal(t, 0.5, f)
+	d, err := sheet.CellDuration()
+	require.NoError(t, err)
+	require.Equal(t, 12*time.Hour, d)
+	s, err = sheet.CellFormatValue()
+	require.NoError(t, err)
+	require.Equal(t, "12:00:00", s)
+
+	require.True(t, sheet.NextCell())
+	v, err = sheet.CellTime()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC), v)
+	v, err = sheet.CellTimeIn(loc)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, time.March, 1, 10, 0, 0, 0, loc), v)
+	value, err = sheet.Value()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC), value)
+	f, err = sheet.CellFloat()
+	require.NoError(t, err)
+	require.Equal(t, 45352+10.0/24, f)
+	s, err = sheet.CellFormatValue()
+	require.NoError(t, err)
+	require.Equal(t, "2024-03-01 10:00", s)
+
+	require.True(t, sheet.NextCell())
+	f, err = sheet.CellFloat()
+	require.NoError(t, err)
+	require.Equal(t, 1.0, f)
+
+	require.True(t, sheet.NextCell())
+	s, err = sheet.CellFormatValue()
+	require.NoError(t, err)
+	require.Equal(t, "2024-03-05T10:30:00", s)
+	f, err = sheet.CellFloat()
+	require.NoError(t, err)
+	require.Equal(t, 45356.4375, f)
+}
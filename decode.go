@@ -0,0 +1,417 @@
+package xlsx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CellUnmarshaler is implemented by types that decode themselves from
+// the current cell of a Sheet.
+type CellUnmarshaler interface {
+	UnmarshalCell(s *Sheet) error
+}
+
+// FieldError reports a failure to decode a cell into a struct field.
+type FieldError struct {
+	Field string
+	Cell  string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("can not decode cell %s into field %s: %v", e.Cell, e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	unmarshalerType = reflect.TypeFor[CellUnmarshaler]()
+	timeType        = reflect.TypeFor[time.Time]()
+	durationType    = reflect.TypeFor[time.Duration]()
+	structInfoCache sync.Map
+)
+
+type structInfo struct {
+	fields    []fieldInfo
+	hasHeader bool
+}
+
+type fieldInfo struct {
+	name     string
+	index    []int
+	col      int
+	header   string
+	optional bool
+}
+
+type rowDecoder struct {
+	info *structInfo
+	cols [][]int
+}
+
+// DecodeRow reads the remaining cells of the current row into the struct
+// pointed to by dst. Fields are mapped with `xlsx:"col=D"` or
+// `xlsx:"header=Count"` tags; header tags require the header row to be
+// read first. Fields whose cells are absent are left untouched.
+func (s *Sheet) DecodeRow(dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("can not decode row into %T: %w", dst, ErrInvalidDecodeTarget)
+	}
+
+	d, err := s.rowDecoder(v.Elem().Type())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for s.NextCell() {
+		if s.Col >= len(d.cols) {
+			continue
+		}
+		for _, i := range d.cols[s.Col] {
+			field := &d.info.fields[i]
+			if er := s.decodeCell(fieldByIndex(v.Elem(), field.index)); er != nil {
+				errs = append(errs, &FieldError{
+					Field: field.name,
+					Cell:  cellName(s.Col, s.Row),
+					Err:   er,
+				})
+			}
+		}
+	}
+	if s.err != nil && !errors.Is(s.err, io.EOF) {
+		return s.err
+	}
+
+	return errors.Join(errs...)
+}
+
+// DecodeAll decodes every remaining row of the sheet into a value of type T,
+// which must be a struct. If T has header tags and no header has been read
+// yet, the first non-empty row is used as the header. Rows with cells that
+// can't be decoded are left out of the result and their FieldErrors are
+// joined into the returned error, other errors stop decoding.
+func DecodeAll[T any](s *Sheet) ([]T, error) {
+	info, err := getStructInfo(reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+
+	if info.hasHeader && s.header == nil {
+		err = s.ReadHeader(HeaderOptions{Row: AutoHeaderRow, AllowDuplicates: true})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		result []T
+		errs   []error
+	)
+	for s.NextRow() {
+		var item T
+		err = s.DecodeRow(&item)
+		if err != nil {
+			var fieldErr *FieldError
+			if !errors.As(err, &fieldErr) {
+				return result, errors.Join(append(errs, err)...)
+			}
+			errs = append(errs, err)
+			continue
+		}
+		result = append(result, item)
+	}
+	if s.err != nil && !errors.Is(s.err, io.EOF) {
+		return result, errors.Join(append(errs, s.err)...)
+	}
+
+	return result, errors.Join(errs...)
+}
+
+func (s *Sheet) rowDecoder(typ reflect.Type) (*rowDecoder, error) {
+	if d, ok := s.decoders[typ]; ok {
+		return d, nil
+	}
+
+	info, err := getStructInfo(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &rowDecoder{info: info}
+	for i, field := range info.fields {
+		col := field.col
+		if field.header != "" {
+			c, er := s.HeaderColumn(field.header)
+			if er != nil {
+				if field.optional {
+					continue
+				}
+				return nil, fmt.Errorf("field %s: %w", field.name, er)
+			}
+			col = c
+		}
+
+		if col >= len(d.cols) {
+			d.cols = append(d.cols, make([][]int, col+1-len(d.cols))...)
+		}
+		d.cols[col] = append(d.cols[col], i)
+	}
+
+	if s.decoders == nil {
+		s.decoders = make(map[reflect.Type]*rowDecoder)
+	}
+	s.decoders[typ] = d
+	return d, nil
+}
+
+func getStructInfo(typ reflect.Type) (*structInfo, error) {
+	if info, ok := structInfoCache.Load(typ); ok {
+		return info.(*structInfo), nil
+	}
+
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("can not decode row into %s: %w", typ, ErrInvalidDecodeTarget)
+	}
+
+	info := &structInfo{}
+	err := collectFields(info, typ, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := structInfoCache.LoadOrStore(typ, info)
+	return actual.(*structInfo), nil
+}
+
+func collectFields(info *structInfo, typ reflect.Type, index []int, prefix string) error {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag, hasTag := field.Tag.Lookup("xlsx")
+		if tag == "-" {
+			continue
+		}
+
+		fieldIndex := append(append([]int(nil), index...), i)
+		name := prefix + field.Name
+
+		if !hasTag {
+			if !field.Anonymous {
+				continue
+			}
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Pointer {
+				if !field.IsExported() {
+					continue
+				}
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() != reflect.Struct {
+				continue
+			}
+			err := collectFields(info, fieldType, fieldIndex, name+".")
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !field.IsExported() {
+			return fmt.Errorf("unexported field %s has xlsx tag: %w", name, ErrInvalidTag)
+		}
+
+		f := fieldInfo{
+			name:     name,
+			index:    fieldIndex,
+			optional: field.Type.Kind() == reflect.Pointer,
+		}
+		key, value, _ := strings.Cut(tag, "=")
+		switch strings.TrimSpace(key) {
+		case "col":
+			col, ok := parseColumnName(strings.TrimSpace(value))
+			if !ok {
+				return fmt.Errorf("invalid column %q for field %s: %w", value, name, ErrInvalidTag)
+			}
+			f.col = col
+		case "header":
+			if value == "" {
+				return fmt.Errorf("empty header for field %s: %w", name, ErrInvalidTag)
+			}
+			f.header = value
+			info.hasHeader = true
+		default:
+			return fmt.Errorf("invalid tag %q for field %s: %w", tag, name, ErrInvalidTag)
+		}
+
+		if !isDecodableType(field.Type) {
+			return fmt.Errorf("field %s has unsupported type %s: %w", name, field.Type, ErrUnsupportedFieldType)
+		}
+
+		info.fields = append(info.fields, f)
+	}
+
+	return nil
+}
+
+func parseColumnName(name string) (int, bool) {
+	if name == "" || len(name) > 3 {
+		return 0, false
+	}
+	upper := []byte(strings.ToUpper(name))
+	for _, b := range upper {
+		if b < 'A' || b > 'Z' {
+			return 0, false
+		}
+	}
+	return columnIndex(upper), true
+}
+
+func isDecodableType(typ reflect.Type) bool {
+	if reflect.PointerTo(typ).Implements(unmarshalerType) || typ == timeType {
+		return true
+	}
+
+	switch typ.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Pointer:
+		return typ.Elem().Kind() != reflect.Pointer && isDecodableType(typ.Elem())
+	default:
+		return false
+	}
+}
+
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+func (s *Sheet) decodeCell(v reflect.Value) error {
+	if v.Kind() == reflect.Pointer {
+		if len(s.cellValue) == 0 {
+			return nil
+		}
+		elem := reflect.New(v.Type().Elem())
+		err := s.decodeCell(elem.Elem())
+		if err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	if u, ok := v.Addr().Interface().(CellUnmarshaler); ok {
+		return u.UnmarshalCell(s)
+	}
+
+	if v.Kind() == reflect.String {
+		val, err := s.CellValue()
+		if err != nil {
+			return err
+		}
+		v.SetString(val)
+		return nil
+	}
+
+	if len(s.cellValue) == 0 {
+		return nil
+	}
+
+	if v.Type() == timeType {
+		val, err := s.CellTime()
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(val))
+		return nil
+	}
+
+	if v.Type() == durationType {
+		val, err := s.CellDuration()
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(val))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		val, err := s.CellValue()
+		if err != nil {
+			return err
+		}
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		val, err := s.decodeInt()
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(val) {
+			return fmt.Errorf("value %d overflows %s: %w", val, v.Type(), strconv.ErrRange)
+		}
+		v.SetInt(val)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		val, err := s.decodeInt()
+		if err != nil {
+			return err
+		}
+		if val < 0 || v.OverflowUint(uint64(val)) {
+			return fmt.Errorf("value %d overflows %s: %w", val, v.Type(), strconv.ErrRange)
+		}
+		v.SetUint(uint64(val))
+	case reflect.Float32, reflect.Float64:
+		val, err := s.CellFloat()
+		if err != nil {
+			return err
+		}
+		if v.OverflowFloat(val) {
+			return fmt.Errorf("value %g overflows %s: %w", val, v.Type(), strconv.ErrRange)
+		}
+		v.SetFloat(val)
+	default:
+		return fmt.Errorf("%s: %w", v.Type(), ErrUnsupportedFieldType)
+	}
+
+	return nil
+}
+
+// decodeInt returns the cell value as an integer. Whole numbers are often
+// stored as floats like 3.0 or 1E3, they are accepted when CellInt fails.
+func (s *Sheet) decodeInt() (int64, error) {
+	val, err := s.CellInt()
+	if err == nil {
+		return int64(val), nil
+	}
+
+	f, er := s.CellFloat()
+	if er != nil || f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, err
+	}
+	return int64(f), nil
+}
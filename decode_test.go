@@ -0,0 +1,164 @@
+package xlsx
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type decodeBase struct {
+	ID int `xlsx:"header=Id"`
+}
+
+type decodeItem struct {
+	decodeBase
+	Text  string   `xlsx:"col=A"`
+	Name  string   `xlsx:"header=Name"`
+	Count *int     `xlsx:"header=Count"`
+	Price *float64 `xlsx:"header=Price"`
+	Fill  upper    `xlsx:"col=E"`
+	Skip  string   `xlsx:"-"`
+}
+
+type upper string
+
+func (u *upper) UnmarshalCell(s *Sheet) error {
+	val, err := s.CellValue()
+	if err != nil {
+		return err
+	}
+	*u = upper(strings.ToUpper(val))
+	return nil
+}
+
+func TestDecodeAll(t *testing.T) {
+	data, err := os.ReadFile("testdata/multi_row.xlsx")
+	require.NoError(t, err)
+
+	br := bytes.NewReader(data)
+	xlsx, err := New(br, br.Size())
+	require.NoError(t, err)
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	items, err := DecodeAll[decodeItem](sheet)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+
+	item := items[0]
+	require.Equal(t, 1245237, item.ID)
+	require.Equal(t, "Some text", item.Text)
+	require.Equal(t, "something", item.Name)
+	require.NotNil(t, item.Count)
+	require.Equal(t, 5, *item.Count)
+	require.Nil(t, item.Price)
+	require.Equal(t, upper("FILLED"), item.Fill)
+}
+
+func TestDecodeAllPartial(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="A1" t="inlineStr"><is><t>Name</t></is></c><c r="B1" t="inlineStr"><is><t>Count</t></is></c></row>` +
+			`<row r="2"><c r="A2" t="inlineStr"><is><t>a</t></is></c><c r="B2"><v>3.0</v></c></row>` +
+			`<row r="3"><c r="A3" t="inlineStr"><is><t>b</t></is></c><c r="B3" t="inlineStr"><is><t>bad</t></is></c></row>` +
+			`<row r="4"><c r="A4" t="inlineStr"><is><t>c</t></is></c><c r="B4"><v>1E1</v></c></row>` +
+			`<row r="5"><c r="A5" t="inlineStr"><is><t>d</t></is></c><c r="B5"><v>2.5</v></c></row>` +
+			`</sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	type row struct {
+		Name  string `xlsx:"header=Name"`
+		Count uint   `xlsx:"header=Count"`
+	}
+	items, err := DecodeAll[row](sheet)
+	require.Equal(t, []row{{Name: "a", Count: 3}, {Name: "c", Count: 10}}, items)
+
+	var fieldErr *FieldError
+	require.ErrorAs(t, err, &fieldErr)
+	require.Equal(t, "B3", fieldErr.Cell)
+	require.Contains(t, err.Error(), "B5")
+}
+
+func TestDecodeRow(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="A1" t="inlineStr"><is><t>abc</t></is></c><c r="B1"><v>1.5</v></c><c r="C1"/><c r="D1" t="b"><v>1</v></c><c r="E1"><v>45352.5</v></c></row>` +
+			`<row r="2"><c r="A2" t="inlineStr"><is><t>x</t></is></c><c r="B2" t="inlineStr"><is><t>bad</t></is></c><c r="C2"><v>300</v></c></row>` +
+			`</sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	type row struct {
+		Name  string    `xlsx:"col=A"`
+		Price float64   `xlsx:"col=B"`
+		Count *int      `xlsx:"col=C"`
+		Small int8      `xlsx:"col=C"`
+		Flag  bool      `xlsx:"col=D"`
+		Date  time.Time `xlsx:"col=E"`
+	}
+
+	require.True(t, sheet.NextRow())
+	var r row
+	require.NoError(t, sheet.DecodeRow(&r))
+	require.Equal(t, "abc", r.Name)
+	require.Equal(t, 1.5, r.Price)
+	require.Nil(t, r.Count)
+	require.True(t, r.Flag)
+	require.Equal(t, time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC), r.Date)
+
+	require.True(t, sheet.NextRow())
+	r = row{}
+	err = sheet.DecodeRow(&r)
+	require.Error(t, err)
+
+	var fieldErr *FieldError
+	require.ErrorAs(t, err, &fieldErr)
+	require.Equal(t, "B2", fieldErr.Cell)
+	require.Equal(t, "Price", fieldErr.Field)
+	require.Contains(t, err.Error(), "C2")
+	require.NotNil(t, r.Count)
+	require.Equal(t, 300, *r.Count)
+}
+
+func TestDecodeInvalidTarget(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData><row r="1"><c r="A1"><v>1</v></c></row></sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	require.True(t, sheet.NextRow())
+
+	var n int
+	require.ErrorIs(t, sheet.DecodeRow(&n), ErrInvalidDecodeTarget)
+
+	var badTag struct {
+		A int `xlsx:"col=1"`
+	}
+	require.ErrorIs(t, sheet.DecodeRow(&badTag), ErrInvalidTag)
+
+	var badType struct {
+		A []int `xlsx:"col=A"`
+	}
+	require.ErrorIs(t, sheet.DecodeRow(&badType), ErrUnsupportedFieldType)
+
+	var noHeader struct {
+		A int `xlsx:"header=A"`
+	}
+	require.ErrorIs(t, sheet.DecodeRow(&noHeader), ErrHeaderNotFound)
+}
@@ -0,0 +1,136 @@
+package xlsx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefinedName is a workbook name. Scope is the name of the sheet the name is
+// local to and is empty for names with workbook scope. Formula is the text the
+// name refers to, like Sheet1!$A$1:$B$10.
+type DefinedName struct {
+	Name    string
+	Scope   string
+	Hidden  bool
+	Formula string
+}
+
+// DefinedNames returns the defined names of the workbook, including built-in
+// names like _xlnm.Print_Area.
+func (x *Xlsx) DefinedNames() []DefinedName {
+	result := make([]DefinedName, len(x.definedNames))
+	copy(result, x.definedNames)
+	return result
+}
+
+// findDefinedName returns the value of a defined name as seen from the given
+// sheet, names local to the sheet take precedence over workbook names.
+func (x *Xlsx) findDefinedName(name, sheet string) (string, bool) {
+	value, found := "", false
+	for _, dn := range x.definedNames {
+		if !strings.EqualFold(dn.Name, name) {
+			continue
+		}
+		if dn.Scope == sheet && sheet != "" {
+			return dn.Formula, true
+		}
+		if dn.Scope == "" {
+			value, found = dn.Formula, true
+		}
+	}
+	return value, found
+}
+
+// localDefinedName returns the value of a name local to the given sheet.
+func (x *Xlsx) localDefinedName(name, sheet string) (string, bool) {
+	for _, dn := range x.definedNames {
+		if dn.Scope == sheet && strings.EqualFold(dn.Name, name) {
+			return dn.Formula, true
+		}
+	}
+	return "", false
+}
+
+// lookupName finds a name by itself or qualified with its sheet, like
+// Sheet1!_xlnm.Print_Area. An unqualified name is looked up in the workbook
+// scope first and then among sheet-local names, where it must be unique.
+func (x *Xlsx) lookupName(name string) (DefinedName, error) {
+	if i := strings.LastIndexByte(name, '!'); i >= 0 {
+		sheet := name[:i]
+		if len(sheet) > 1 && strings.HasPrefix(sheet, "'") && strings.HasSuffix(sheet, "'") {
+			sheet = strings.ReplaceAll(sheet[1:len(sheet)-1], "''", "'")
+		}
+		for _, dn := range x.definedNames {
+			if dn.Scope == sheet && strings.EqualFold(dn.Name, name[i+1:]) {
+				return dn, nil
+			}
+		}
+		return DefinedName{}, fmt.Errorf("can not find name %s: %w", name, ErrDefinedNameNotFound)
+	}
+
+	var (
+		local DefinedName
+		count int
+	)
+	for _, dn := range x.definedNames {
+		if !strings.EqualFold(dn.Name, name) {
+			continue
+		}
+		if dn.Scope == "" {
+			return dn, nil
+		}
+		local = dn
+		count++
+	}
+
+	switch count {
+	case 0:
+		return DefinedName{}, fmt.Errorf("can not find name %s: %w", name, ErrDefinedNameNotFound)
+	case 1:
+		return local, nil
+	}
+	return DefinedName{}, fmt.Errorf("name %s is local to several sheets: %w", name, ErrAmbiguousName)
+}
+
+// resolveNameRange resolves the formula of a name to a single range. Names
+// referring to other names are followed.
+func (x *Xlsx) resolveNameRange(formula, scope string, depth int) (string, Range, error) {
+	formula = strings.TrimSpace(strings.TrimPrefix(formula, "="))
+
+	sheet, cell := splitLocation(formula)
+	if cell != "" {
+		if sheet == "" {
+			sheet = scope
+		}
+		r, err := ParseRange(cell)
+		if err != nil || sheet == "" {
+			return "", Range{}, fmt.Errorf("can not resolve %s to a range: %w", formula, ErrInvalidRange)
+		}
+		return sheet, r, nil
+	}
+
+	if depth < maxNameDepth {
+		if value, ok := x.findDefinedName(formula, scope); ok {
+			return x.resolveNameRange(value, scope, depth+1)
+		}
+	}
+
+	return "", Range{}, fmt.Errorf("can not resolve %s to a range: %w", formula, ErrInvalidRange)
+}
+
+// OpenRange opens the worksheet a defined name refers to, restricted to the
+// rows and columns of the range, see WithRange. The name must refer to
+// a single range.
+func (x *Xlsx) OpenRange(name string, opts ...SheetOption) (*Sheet, error) {
+	dn, err := x.lookupName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	sheet, r, err := x.resolveNameRange(dn.Formula, dn.Scope, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return x.OpenSheetByName(sheet, append(opts[:len(opts):len(opts)], WithRange(r))...)
+}
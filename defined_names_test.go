@@ -0,0 +1,64 @@
+package xlsx
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefinedNames(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets><definedNames>` +
+			`<definedName name="_xlnm.Print_Area" localSheetId="0">Sheet1!$A$1:$B$3</definedName>` +
+			`<definedName name="Prices">Sheet1!$B$2:$C$3</definedName>` +
+			`<definedName name="Alias" hidden="1">Prices</definedName>` +
+			`<definedName name="Areas">Sheet1!$A$1,Sheet1!$C$3</definedName>` +
+			`<definedName name="Rate">0.2</definedName>` +
+			`</definedNames></workbook>`,
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="A1"><v>1</v></c><c r="B1"><v>2</v></c><c r="C1"><v>3</v></c></row>` +
+			`<row r="2"><c r="A2"><v>4</v></c><c r="B2"><v>5</v></c><c r="C2"><v>6</v></c></row>` +
+			`<row r="3"><c r="A3"><v>7</v></c><c r="B3"><v>8</v></c><c r="C3"><v>9</v></c></row>` +
+			`<row r="4"><c r="A4"><v>10</v></c></row>` +
+			`</sheetData>`),
+	})
+
+	require.Equal(t, []DefinedName{
+		{Name: "_xlnm.Print_Area", Scope: "Sheet1", Formula: "Sheet1!$A$1:$B$3"},
+		{Name: "Prices", Formula: "Sheet1!$B$2:$C$3"},
+		{Name: "Alias", Hidden: true, Formula: "Prices"},
+		{Name: "Areas", Formula: "Sheet1!$A$1,Sheet1!$C$3"},
+		{Name: "Rate", Formula: "0.2"},
+	}, xlsx.DefinedNames())
+
+	readRange := func(name string) []string {
+		sheet, err := xlsx.OpenRange(name)
+		require.NoError(t, err)
+		defer sheet.Close()
+
+		var values []string
+		for sheet.NextRow() {
+			for sheet.NextCell() {
+				v, err := sheet.CellValue()
+				require.NoError(t, err)
+				values = append(values, cellName(sheet.Col, sheet.Row)+"="+v)
+			}
+		}
+		require.ErrorIs(t, sheet.Err(), io.EOF)
+		return values
+	}
+
+	require.Equal(t, []string{"B2=5", "C2=6", "B3=8", "C3=9"}, readRange("Prices"))
+	require.Equal(t, []string{"B2=5", "C2=6", "B3=8", "C3=9"}, readRange("alias"))
+	require.Equal(t, []string{"A1=1", "B1=2", "A2=4", "B2=5", "A3=7", "B3=8"}, readRange("_xlnm.Print_Area"))
+	require.Equal(t, []string{"A1=1", "B1=2", "A2=4", "B2=5", "A3=7", "B3=8"}, readRange("Sheet1!_xlnm.Print_Area"))
+
+	_, err := xlsx.OpenRange("Areas")
+	require.ErrorIs(t, err, ErrInvalidRange)
+	_, err = xlsx.OpenRange("Rate")
+	require.ErrorIs(t, err, ErrInvalidRange)
+	_, err = xlsx.OpenRange("Missing")
+	require.ErrorIs(t, err, ErrDefinedNameNotFound)
+}
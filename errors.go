@@ -6,16 +6,42 @@ var (
 	ErrWorkbookRelsNotExist  = errors.New("parse xlsx file failed: xl/_rels/workbook.xml.rels doesn't exist")
 	ErrWorkbookNotExist      = errors.New("parse xlsx file failed: xl/workbook.xml doesn't exist")
 	ErrSheetNotFound         = errors.New("sheet not found")
+	ErrNotWorksheet          = errors.New("sheet is not a worksheet")
 	ErrIncorrectSheet        = errors.New("incorrect sheet")
 	ErrIncorrectSharedString = errors.New("incorrect shared string")
+	ErrIncorrectStyles       = errors.New("incorrect styles")
 	ErrDoubleQuote           = errors.New("invalid format string, unmatched double quote")
 	ErrManySections          = errors.New("invalid number format, too many format sections")
 	ErrInvalidBrackets       = errors.New("invalid formatting code, invalid brackets")
-	ErrInvalidCurrency       = errors.New("invalid formatting code, invalid currency annotation")
-	EUnsupportedCharacters   = errors.New("invalid formatting code: unsupported or unescaped characters")
 	ErrUnknownCellType       = errors.New("unknown cell type")
 	ErrInvalidBool           = errors.New("invalid value in bool cell")
+	ErrTimeOutOfRange        = errors.New("excel time out of range")
 	ErrInvalidFormat         = errors.New("invalid or unsupported format")
 	ErrNoClosingQuote        = errors.New("no closing quote found")
 	ErrRowMissingR           = errors.New("row element missing 'r' attribute")
+	ErrInvalidDecodeTarget   = errors.New("decode target must be a non-nil pointer to a struct")
+	ErrInvalidTag            = errors.New("invalid xlsx struct tag")
+	ErrUnsupportedFieldType  = errors.New("unsupported field type")
+	ErrHeaderNotFound        = errors.New("header not found")
+	ErrDuplicateHeader       = errors.New("duplicate header")
+	ErrSharedFormulaNotFound = errors.New("shared formula not found")
+	ErrInvalidRange          = errors.New("invalid range")
+	ErrIncorrectComments     = errors.New("incorrect comments")
+	ErrRowConsumed           = errors.New("cells of the current row were already read")
+	ErrInvalidListSource     = errors.New("unsupported data validation list source")
+	ErrDefinedNameNotFound   = errors.New("defined name not found")
+	ErrAmbiguousName         = errors.New("ambiguous defined name")
+	ErrTableNotFound         = errors.New("table not found")
+)
+
+// Errors kept for callers of the number format parser that no longer
+// returns them.
+var (
+	// Deprecated: currency blocks without a locale like [$€] are valid and
+	// formatted, the number format parser no longer returns this error.
+	ErrInvalidCurrency = errors.New("invalid formatting code, invalid currency annotation")
+
+	// Deprecated: unescaped characters are shown as literals the way Excel
+	// does, the number format parser no longer returns this error.
+	EUnsupportedCharacters = errors.New("invalid formatting code: unsupported or unescaped characters")
 )
@@ -1,5 +1,12 @@
 package xlsx
 
+import "strconv"
+
+const (
+	maxColumns = 16384
+	maxRows    = 1048576
+)
+
 func columnIndex(s []byte) int {
 	result := 0
 	for _, r := range s {
@@ -10,3 +17,48 @@ func columnIndex(s []byte) int {
 	}
 	return result - 1
 }
+
+func columnName(col int) string {
+	var buf [8]byte
+	i := len(buf)
+	for n := col + 1; n > 0; n = (n - 1) / 26 {
+		i--
+		buf[i] = byte('A' + (n-1)%26)
+	}
+	return string(buf[i:])
+}
+
+func cellName(col, row int) string {
+	return columnName(col) + strconv.Itoa(row+1)
+}
+
+// parseCellRef parses a reference like B12 into zero-based column and row.
+func parseCellRef(ref []byte) (int, int, bool) {
+	col := 0
+	i := 0
+	for ; i < len(ref) && ref[i] >= 'A' && ref[i] <= 'Z'; i++ {
+		col = col*26 + int(ref[i]-'A') + 1
+		if col > maxColumns {
+			return 0, 0, false
+		}
+	}
+	if i == 0 || i == len(ref) {
+		return 0, 0, false
+	}
+
+	row := 0
+	for ; i < len(ref); i++ {
+		if ref[i] < '0' || ref[i] > '9' {
+			return 0, 0, false
+		}
+		row = row*10 + int(ref[i]-'0')
+		if row > maxRows {
+			return 0, 0, false
+		}
+	}
+	if row == 0 {
+		return 0, 0, false
+	}
+
+	return col - 1, row - 1, true
+}
@@ -14,3 +14,12 @@ func TestColumnIndex(t *testing.T) {
 	require.Equal(t, 27, columnIndex([]byte("AB")))
 	require.Equal(t, 27, columnIndex([]byte("AB33")))
 }
+
+func TestColumnName(t *testing.T) {
+	require.Equal(t, "A", columnName(0))
+	require.Equal(t, "Z", columnName(25))
+	require.Equal(t, "AA", columnName(26))
+	require.Equal(t, "AB", columnName(27))
+	require.Equal(t, "XFD", columnName(16383))
+	require.Equal(t, "D5", cellName(3, 4))
+}
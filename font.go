@@ -0,0 +1,183 @@
+package xlsx
+
+import (
+	"strconv"
+
+	"github.com/anfilat/xlsx-sax/internal/xml"
+)
+
+type ColorKind int
+
+const (
+	ColorNone ColorKind = iota
+	ColorRGB
+	ColorTheme
+	ColorIndexed
+	ColorAuto
+)
+
+// Color is a color reference as written in the file. RGB holds the ARGB
+// value for ColorRGB, Theme and Indexed hold the palette index for
+// ColorTheme and ColorIndexed. ARGB is the resolved value with the tint
+// applied, empty when the color can't be resolved.
+type Color struct {
+	Kind    ColorKind
+	RGB     string
+	Theme   int
+	Indexed int
+	Tint    float64
+	ARGB    string
+}
+
+type Font struct {
+	Name      string
+	Size      float64
+	Bold      bool
+	Italic    bool
+	Underline string
+	Strike    bool
+	VertAlign string
+	Color     Color
+	Family    int
+	Scheme    string
+}
+
+// RichTextRun is a piece of text with its own font. Font is nil for runs
+// without run properties.
+type RichTextRun struct {
+	Text string
+	Font *Font
+}
+
+func fontTagAttrs() []xml.TagAttrs {
+	result := []xml.TagAttrs{colorTagAttrs("color")}
+	for _, name := range []string{"b", "i", "strike", "u", "sz", "rFont", "name", "family", "scheme", "vertAlign"} {
+		result = append(result, xml.TagAttrs{
+			Name: name,
+			Attr: []xml.TagAttr{
+				{Name: "val"},
+			},
+		})
+	}
+	return result
+}
+
+func colorTagAttrs(name string) xml.TagAttrs {
+	return xml.TagAttrs{
+		Name: name,
+		Attr: []xml.TagAttr{
+			{Name: "rgb"},
+			{Name: "theme"},
+			{Name: "indexed"},
+			{Name: "tint"},
+			{Name: "auto"},
+		},
+	}
+}
+
+// readFont reads the children of a font or run properties element,
+// including its end element.
+func readFont(decoder *xml.Decoder) (*Font, error) {
+	font := &Font{}
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := t.(type) {
+		case *xml.StartElement:
+			switch token.Name.Local {
+			case "b":
+				font.Bold = boolValAttr(token.Attr)
+			case "i":
+				font.Italic = boolValAttr(token.Attr)
+			case "strike":
+				font.Strike = boolValAttr(token.Attr)
+			case "u":
+				font.Underline = valAttr(token.Attr)
+				if font.Underline == "" {
+					font.Underline = "single"
+				}
+			case "sz":
+				font.Size, _ = strconv.ParseFloat(valAttr(token.Attr), 64)
+			case "rFont", "name":
+				font.Name = valAttr(token.Attr)
+			case "family":
+				font.Family, _ = strconv.Atoi(valAttr(token.Attr))
+			case "scheme":
+				font.Scheme = valAttr(token.Attr)
+			case "vertAlign":
+				font.VertAlign = valAttr(token.Attr)
+			case "color":
+				font.Color = parseColor(token.Attr)
+			}
+			if er := decoder.Skip(); er != nil {
+				return nil, er
+			}
+		case *xml.EndElement:
+			return font, nil
+		}
+	}
+}
+
+func parseColor(attrs []xml.Attr) Color {
+	var (
+		c       Color
+		auto    bool
+		theme   = -1
+		indexed = -1
+	)
+	for _, a := range attrs {
+		switch a.Name.Local {
+		case "rgb":
+			c.RGB = a.Value.String()
+		case "theme":
+			if n, err := strconv.Atoi(a.Value.String()); err == nil {
+				theme = n
+			}
+		case "indexed":
+			if n, err := strconv.Atoi(a.Value.String()); err == nil {
+				indexed = n
+			}
+		case "auto":
+			auto = parseBoolAttr(a.Value.Bytes())
+		case "tint":
+			c.Tint, _ = strconv.ParseFloat(a.Value.String(), 64)
+		}
+	}
+
+	switch {
+	case c.RGB != "":
+		c.Kind = ColorRGB
+	case theme >= 0:
+		c.Kind = ColorTheme
+		c.Theme = theme
+	case indexed >= 0:
+		c.Kind = ColorIndexed
+		c.Indexed = indexed
+	case auto:
+		c.Kind = ColorAuto
+	}
+	return c
+}
+
+func valAttr(attrs []xml.Attr) string {
+	for _, a := range attrs {
+		if a.Name.Local == "val" {
+			return a.Value.String()
+		}
+	}
+	return ""
+}
+
+// boolValAttr reads elements like <b/> or <b val="0"/>, where a missing
+// val means true.
+func boolValAttr(attrs []xml.Attr) bool {
+	for _, a := range attrs {
+		if a.Name.Local == "val" {
+			return parseBoolAttr(a.Value.Bytes())
+		}
+	}
+	return true
+}
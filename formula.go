@@ -0,0 +1,403 @@
+package xlsx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/anfilat/xlsx-sax/internal/xml"
+)
+
+type FormulaType int
+
+const (
+	FormulaNone FormulaType = iota
+	FormulaNormal
+	FormulaShared
+	FormulaArray
+	FormulaDataTable
+)
+
+// Formula describes the formula of a cell. Text has no leading '=' and for
+// cells sharing a formula it is shifted relative to the master cell.
+type Formula struct {
+	Text string
+	Type FormulaType
+	// SharedIndex is the shared group index, valid for FormulaShared only.
+	SharedIndex int
+	// Ref is the range of a shared, array or data table formula as written
+	// in the master cell.
+	Ref string
+	// R1, R2, DataTable2D and DataTableRow are the data table inputs.
+	R1           string
+	R2           string
+	DataTable2D  bool
+	DataTableRow bool
+}
+
+type cellFormula struct {
+	typ  FormulaType
+	si   int
+	text []byte
+	ref  []byte
+	r1   []byte
+	r2   []byte
+	dt2D bool
+	dtr  bool
+}
+
+type sharedFormula struct {
+	text string
+	row  int
+	col  int
+}
+
+func (f *cellFormula) reset() {
+	f.typ = FormulaNone
+	f.si = 0
+	f.text = f.text[:0]
+	f.ref = f.ref[:0]
+	f.r1 = f.r1[:0]
+	f.r2 = f.r2[:0]
+	f.dt2D = false
+	f.dtr = false
+}
+
+func (f *cellFormula) copyFrom(src *cellFormula) {
+	f.typ = src.typ
+	f.si = src.si
+	f.text = append(f.text[:0], src.text...)
+	f.ref = append(f.ref[:0], src.ref...)
+	f.r1 = append(f.r1[:0], src.r1...)
+	f.r2 = append(f.r2[:0], src.r2...)
+	f.dt2D = src.dt2D
+	f.dtr = src.dtr
+}
+
+func (f *cellFormula) parseAttrs(attrs []xml.Attr) error {
+	f.typ = FormulaNormal
+	for _, a := range attrs {
+		switch a.Name.Local {
+		case "t":
+			switch string(a.Value.Bytes()) {
+			case "shared":
+				f.typ = FormulaShared
+			case "array":
+				f.typ = FormulaArray
+			case "dataTable":
+				f.typ = FormulaDataTable
+			}
+		case "si":
+			si, err := strconv.Atoi(a.Value.String())
+			if err != nil {
+				return ErrIncorrectSheet
+			}
+			f.si = si
+		case "ref":
+			f.ref = append(f.ref[:0], a.Value.Bytes()...)
+		case "r1":
+			f.r1 = append(f.r1[:0], a.Value.Bytes()...)
+		case "r2":
+			f.r2 = append(f.r2[:0], a.Value.Bytes()...)
+		case "dt2D":
+			f.dt2D = parseBoolAttr(a.Value.Bytes())
+		case "dtr":
+			f.dtr = parseBoolAttr(a.Value.Bytes())
+		}
+	}
+	return nil
+}
+
+func (f *cellFormula) isSharedMaster() bool {
+	return f.typ == FormulaShared && len(f.ref) > 0 && len(f.text) > 0
+}
+
+func parseBoolAttr(b []byte) bool {
+	return string(b) == "1" || string(b) == "true"
+}
+
+func (s *Sheet) addSharedFormula(f *cellFormula, row, col int) {
+	if s.sharedFormulas == nil {
+		s.sharedFormulas = make(map[int]sharedFormula)
+	}
+	s.sharedFormulas[f.si] = sharedFormula{
+		text: string(f.text),
+		row:  row,
+		col:  col,
+	}
+}
+
+// skipFormula reads the formula of a cell that is skipped by NextRow,
+// keeping the master of a shared group for the cells that follow.
+func (s *Sheet) skipFormula(attrs []xml.Attr, cell []byte) error {
+	f := &s.skippedFormula
+	f.reset()
+	err := f.parseAttrs(attrs)
+	if err != nil {
+		return err
+	}
+	if f.typ != FormulaShared || len(f.ref) == 0 {
+		return nil
+	}
+
+	for {
+		t, er := s.decoder.Token()
+		if er != nil {
+			return er
+		}
+		switch token := t.(type) {
+		case *xml.CharData:
+			f.text = append(f.text, token.Value...)
+		case *xml.EndElement:
+			col, row, ok := parseCellRef(cell)
+			if ok && f.isSharedMaster() {
+				s.addSharedFormula(f, row, col)
+			}
+			return nil
+		}
+	}
+}
+
+// CellFormula returns the formula of the current cell. Type is FormulaNone
+// for cells without a formula.
+func (s *Sheet) CellFormula() (Formula, error) {
+	f := &s.formula
+	if f.typ == FormulaNone {
+		return Formula{}, nil
+	}
+
+	result := Formula{
+		Type:         f.typ,
+		Ref:          string(f.ref),
+		R1:           string(f.r1),
+		R2:           string(f.r2),
+		DataTable2D:  f.dt2D,
+		DataTableRow: f.dtr,
+	}
+	if f.typ != FormulaShared || len(f.text) > 0 {
+		result.Text = string(f.text)
+	}
+	if f.typ == FormulaShared {
+		result.SharedIndex = f.si
+		if len(f.text) == 0 {
+			master, ok := s.sharedFormulas[f.si]
+			if !ok {
+				return result, fmt.Errorf("can not find shared formula %d for cell %s: %w", f.si, cellName(s.Col, s.Row), ErrSharedFormulaNotFound)
+			}
+			result.Text = shiftFormula(master.text, s.Row-master.row, s.Col-master.col)
+		}
+	}
+
+	return result, nil
+}
+
+// shiftFormula moves the relative references of a formula by the given
+// number of rows and columns. References that fall off the sheet become #REF!.
+func shiftFormula(formula string, dRow, dCol int) string {
+	if dRow == 0 && dCol == 0 {
+		return formula
+	}
+
+	var b strings.Builder
+	b.Grow(len(formula) + 8)
+
+	for i := 0; i < len(formula); {
+		c := formula[i]
+		switch {
+		case c == '"' || c == '\'':
+			end := skipQuoted(formula, i)
+			b.WriteString(formula[i:end])
+			i = end
+		case c == '[':
+			end := skipBrackets(formula, i)
+			b.WriteString(formula[i:end])
+			i = end
+		case isFormulaNameByte(c):
+			end := i
+			for end < len(formula) && isFormulaNameByte(formula[end]) {
+				end++
+			}
+			token := formula[i:end]
+
+			if end < len(formula) && (formula[end] == '(' || formula[end] == '!' || formula[end] == '[') ||
+				(i > 0 && isFormulaNameByte(formula[i-1])) {
+				b.WriteString(token)
+				i = end
+				continue
+			}
+
+			if end < len(formula) && formula[end] == ':' {
+				next := end + 1
+				for next < len(formula) && isFormulaNameByte(formula[next]) {
+					next++
+				}
+				if shifted, ok := shiftLineRange(token, formula[end+1:next], dRow, dCol); ok {
+					b.WriteString(shifted)
+					i = next
+					continue
+				}
+			}
+
+			if shifted, ok := shiftCellRef(token, dRow, dCol); ok {
+				b.WriteString(shifted)
+			} else {
+				b.WriteString(token)
+			}
+			i = end
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+
+	return b.String()
+}
+
+func isFormulaNameByte(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '$' || c == '_' || c == '.' || c == '\\' || c >= 0x80
+}
+
+func skipQuoted(s string, i int) int {
+	quote := s[i]
+	for j := i + 1; j < len(s); j++ {
+		if s[j] == quote {
+			if j+1 < len(s) && s[j+1] == quote {
+				j++
+				continue
+			}
+			return j + 1
+		}
+	}
+	return len(s)
+}
+
+func skipBrackets(s string, i int) int {
+	depth := 0
+	for j := i; j < len(s); j++ {
+		switch s[j] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return j + 1
+			}
+		case '\'':
+			j++
+		}
+	}
+	return len(s)
+}
+
+type refPart struct {
+	colAbs bool
+	col    int
+	rowAbs bool
+	row    int
+}
+
+// parseRefPart parses A1, $A$1, $A or 1 style reference parts.
+func parseRefPart(s string) (refPart, bool, bool, bool) {
+	var p refPart
+	i := 0
+	if i < len(s) && s[i] == '$' {
+		p.colAbs = true
+		i++
+	}
+	start := i
+	for i < len(s) && (s[i] >= 'A' && s[i] <= 'Z' || s[i] >= 'a' && s[i] <= 'z') {
+		if i-start == 3 {
+			return p, false, false, false
+		}
+		p.col = p.col*26 + int((s[i]|0x20)-'a') + 1
+		i++
+	}
+	hasCol := i > start
+	if p.col > maxColumns {
+		return p, false, false, false
+	}
+	p.col--
+	if !hasCol && p.colAbs {
+		p.colAbs = false
+		p.rowAbs = true
+	} else if i < len(s) && s[i] == '$' {
+		p.rowAbs = true
+		i++
+	}
+	start = i
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		p.row = p.row*10 + int(s[i]-'0')
+		if p.row > maxRows {
+			return p, false, false, false
+		}
+		i++
+	}
+	hasRow := i > start
+	if i != len(s) || !hasCol && !hasRow || hasRow && p.row == 0 {
+		return p, false, false, false
+	}
+	if !hasRow && p.rowAbs {
+		return p, false, false, false
+	}
+	p.row--
+	return p, hasCol, hasRow, true
+}
+
+func (p refPart) shift(dRow, dCol int, hasCol, hasRow bool) (string, bool) {
+	var b strings.Builder
+	if hasCol {
+		col := p.col
+		if !p.colAbs {
+			col += dCol
+		}
+		if col < 0 || col >= maxColumns {
+			return "", false
+		}
+		if p.colAbs {
+			b.WriteByte('$')
+		}
+		b.WriteString(columnName(col))
+	}
+	if hasRow {
+		row := p.row
+		if !p.rowAbs {
+			row += dRow
+		}
+		if row < 0 || row >= maxRows {
+			return "", false
+		}
+		if p.rowAbs {
+			b.WriteByte('$')
+		}
+		b.WriteString(strconv.Itoa(row + 1))
+	}
+	return b.String(), true
+}
+
+func shiftCellRef(token string, dRow, dCol int) (string, bool) {
+	p, hasCol, hasRow, ok := parseRefPart(token)
+	if !ok || !hasCol || !hasRow {
+		return "", false
+	}
+	shifted, ok := p.shift(dRow, dCol, true, true)
+	if !ok {
+		return "#REF!", true
+	}
+	return shifted, true
+}
+
+// shiftLineRange shifts whole column (A:C) and whole row (1:3) ranges.
+func shiftLineRange(from, to string, dRow, dCol int) (string, bool) {
+	p1, hasCol1, hasRow1, ok1 := parseRefPart(from)
+	p2, hasCol2, hasRow2, ok2 := parseRefPart(to)
+	if !ok1 || !ok2 || hasCol1 != hasCol2 || hasRow1 != hasRow2 || hasCol1 && hasRow1 {
+		return "", false
+	}
+
+	s1, ok1 := p1.shift(dRow, dCol, hasCol1, hasRow1)
+	s2, ok2 := p2.shift(dRow, dCol, hasCol2, hasRow2)
+	if !ok1 || !ok2 {
+		return "#REF!", true
+	}
+	return s1 + ":" + s2, true
+}
@@ -0,0 +1,102 @@
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShiftFormula(t *testing.T) {
+	tests := []struct {
+		formula    string
+		dRow, dCol int
+		expected   string
+	}{
+		{"A1+B2", 1, 0, "A2+B3"},
+		{"$A1+A$1+$A$1", 2, 3, "$A3+D$1+$A$1"},
+		{"SUM(A1:B2)", 0, 1, "SUM(B1:C2)"},
+		{"SUM(A:A)+SUM($B:C)", 5, 1, "SUM(B:B)+SUM($B:D)"},
+		{"SUM(1:2)+SUM($3:4)", 1, 5, "SUM(2:3)+SUM($3:5)"},
+		{`IF(A1="B2","C3",LOG10(D4))`, 1, 0, `IF(A2="B2","C3",LOG10(D5))`},
+		{"'My Sheet'!A1+Sheet2!B1", 1, 1, "'My Sheet'!B2+Sheet2!C2"},
+		{"Table1[[#This Row],[A1]]+Rate2024", 1, 1, "Table1[[#This Row],[A1]]+Rate2024"},
+		{"A1*1.5E+3", 1, 0, "A2*1.5E+3"},
+		{"A1", -1, 0, "#REF!"},
+		{"_xlfn.XLOOKUP(A1,B:B,C:C)", 1, 0, "_xlfn.XLOOKUP(A2,B:B,C:C)"},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.expected, shiftFormula(test.formula, test.dRow, test.dCol), test.formula)
+	}
+}
+
+func TestCellFormula(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="A1"><v>1</v></c><c r="B1"><f t="shared" ref="B1:B3" si="0">A1*2</f><v>2</v></c></row>` +
+			`<row r="2"><c r="A2"><v>2</v></c><c r="B2"><f t="shared" si="0"/><v>4</v></c></row>` +
+			`<row r="3"><c r="A3"><v>3</v></c><c r="B3"><f t="shared" si="0"/><v>6</v></c><c r="C3"><f t="array" ref="C3:C4">A3:A4*2</f><v>6</v></c>` +
+			`<c r="D3"><f t="dataTable" ref="D3:E4" dt2D="1" dtr="1" r1="A1" r2="A2"/><v>0</v></c><c r="E3"><f t="shared" si="7"/><v>0</v></c></row>` +
+			`</sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextCell())
+	f, err := sheet.CellFormula()
+	require.NoError(t, err)
+	require.Equal(t, FormulaNone, f.Type)
+
+	require.True(t, sheet.NextCell())
+	f, err = sheet.CellFormula()
+	require.NoError(t, err)
+	require.Equal(t, Formula{Text: "A1*2", Type: FormulaShared, Ref: "B1:B3"}, f)
+
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextCell())
+	require.True(t, sheet.NextCell())
+	f, err = sheet.CellFormula()
+	require.NoError(t, err)
+	require.Equal(t, Formula{Text: "A3*2", Type: FormulaShared}, f)
+	val, err := sheet.CellValue()
+	require.NoError(t, err)
+	require.Equal(t, "6", val)
+
+	require.True(t, sheet.NextCell())
+	f, err = sheet.CellFormula()
+	require.NoError(t, err)
+	require.Equal(t, Formula{Text: "A3:A4*2", Type: FormulaArray, Ref: "C3:C4"}, f)
+
+	require.True(t, sheet.NextCell())
+	f, err = sheet.CellFormula()
+	require.NoError(t, err)
+	require.Equal(t, Formula{Type: FormulaDataTable, Ref: "D3:E4", R1: "A1", R2: "A2", DataTable2D: true, DataTableRow: true}, f)
+
+	require.True(t, sheet.NextCell())
+	_, err = sheet.CellFormula()
+	require.ErrorIs(t, err, ErrSharedFormulaNotFound)
+}
+
+func TestCellFormulaSkippedMaster(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="B1"><f t="shared" ref="B1:B2" si="3">A1+$A$1</f><v>2</v></c></row>` +
+			`<row r="2"><c r="B2"><f t="shared" si="3"/><v>4</v></c></row>` +
+			`</sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextCell())
+	f, err := sheet.CellFormula()
+	require.NoError(t, err)
+	require.Equal(t, Formula{Text: "A2+$A$1", Type: FormulaShared, SharedIndex: 3}, f)
+}
@@ -0,0 +1,203 @@
+package xlsx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// AutoHeaderRow selects the first row with a non-empty cell as the header row.
+const AutoHeaderRow = -1
+
+type HeaderOptions struct {
+	// Row is the zero-based index of the header row or AutoHeaderRow.
+	Row int
+	// IgnoreCase matches header names case-insensitively.
+	IgnoreCase bool
+	// TrimSpace ignores leading and trailing white space in header names.
+	TrimSpace bool
+	// AllowDuplicates lets several columns share a name, the leftmost one wins.
+	AllowDuplicates bool
+	// Required lists header names that must be present.
+	Required []string
+}
+
+type sheetHeader struct {
+	opts    HeaderOptions
+	names   []string
+	columns map[string]int
+}
+
+func (h *sheetHeader) key(name string) string {
+	if h.opts.TrimSpace {
+		name = strings.TrimSpace(name)
+	}
+	if h.opts.IgnoreCase {
+		name = strings.ToLower(name)
+	}
+	return name
+}
+
+// ReadHeader reads rows up to and including the header row and remembers its
+// cell values, so later rows can be accessed by column name.
+func (s *Sheet) ReadHeader(opts HeaderOptions) error {
+	header := &sheetHeader{
+		opts:    opts,
+		columns: make(map[string]int),
+	}
+
+	for {
+		if !s.NextRow() {
+			if errors.Is(s.err, io.EOF) {
+				return fmt.Errorf("can not find header row: %w", ErrHeaderNotFound)
+			}
+			return s.err
+		}
+		if opts.Row >= 0 && s.Row > opts.Row {
+			return fmt.Errorf("can not find header row %d: %w", opts.Row, ErrHeaderNotFound)
+		}
+		if opts.Row >= 0 && s.Row < opts.Row {
+			continue
+		}
+
+		header.names = header.names[:0]
+		for s.NextCell() {
+			val, err := s.CellValue()
+			if err != nil {
+				return err
+			}
+			if s.Col >= len(header.names) {
+				header.names = append(header.names, make([]string, s.Col+1-len(header.names))...)
+			}
+			header.names[s.Col] = val
+		}
+		if s.err != nil && !errors.Is(s.err, io.EOF) {
+			return s.err
+		}
+
+		if opts.Row >= 0 || !isEmptyHeader(header.names) {
+			break
+		}
+	}
+
+	err := header.index()
+	if err != nil {
+		return err
+	}
+
+	s.setHeader(header)
+	return nil
+}
+
+// setHeader makes header the current header of the sheet.
+func (s *Sheet) setHeader(header *sheetHeader) {
+	s.header = header
+	s.decoders = nil
+}
+
+// index maps header names to columns and checks the required names.
+func (h *sheetHeader) index() error {
+	for col, name := range h.names {
+		key := h.key(name)
+		if key == "" {
+			continue
+		}
+		if prev, ok := h.columns[key]; ok {
+			if !h.opts.AllowDuplicates {
+				return fmt.Errorf("header %q in columns %s and %s: %w", name, columnName(prev), columnName(col), ErrDuplicateHeader)
+			}
+			continue
+		}
+		h.columns[key] = col
+	}
+
+	var missing []string
+	for _, name := range h.opts.Required {
+		if _, ok := h.columns[h.key(name)]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required headers %q: %w", missing, ErrHeaderNotFound)
+	}
+
+	return nil
+}
+
+func isEmptyHeader(names []string) bool {
+	for _, name := range names {
+		if strings.TrimSpace(name) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Header returns the header row values indexed by column.
+func (s *Sheet) Header() []string {
+	if s.header == nil {
+		return nil
+	}
+	result := make([]string, len(s.header.names))
+	copy(result, s.header.names)
+	return result
+}
+
+// HeaderColumn returns the column index of the header with the given name.
+func (s *Sheet) HeaderColumn(name string) (int, error) {
+	if s.header == nil {
+		return 0, fmt.Errorf("header row is not read: %w", ErrHeaderNotFound)
+	}
+	col, ok := s.header.columns[s.header.key(name)]
+	if !ok {
+		return 0, fmt.Errorf("can not find column %q: %w", name, ErrHeaderNotFound)
+	}
+	return col, nil
+}
+
+// HeaderValue returns the value of the current row in the column with the
+// given header name. The first call reads the rest of the row, so it must be
+// made before NextCell is used on that row. An error reading the row is
+// returned by every call for that row.
+func (s *Sheet) HeaderValue(name string) (string, error) {
+	col, err := s.HeaderColumn(name)
+	if err != nil {
+		return "", err
+	}
+
+	if !s.rowBuffered {
+		if s.rowStarted {
+			return "", ErrRowConsumed
+		}
+		s.rowErr = s.bufferRow()
+		s.rowBuffered = true
+	}
+	if s.rowErr != nil {
+		return "", s.rowErr
+	}
+
+	if col < len(s.rowValues) {
+		return s.rowValues[col], nil
+	}
+	return "", nil
+}
+
+func (s *Sheet) bufferRow() error {
+	clear(s.rowValues)
+	s.rowValues = s.rowValues[:0]
+	for s.NextCell() {
+		val, err := s.CellValue()
+		if err != nil {
+			return err
+		}
+		if s.Col >= len(s.rowValues) {
+			s.rowValues = append(s.rowValues, make([]string, s.Col+1-len(s.rowValues))...)
+		}
+		s.rowValues[s.Col] = val
+	}
+	if s.err != nil && !errors.Is(s.err, io.EOF) {
+		return s.err
+	}
+	return nil
+}
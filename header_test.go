@@ -0,0 +1,111 @@
+package xlsx
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const headerTestSheet = `<sheetData>` +
+	`<row r="2"><c r="A2" t="inlineStr"><is><t></t></is></c></row>` +
+	`<row r="3"><c r="A3" t="inlineStr"><is><t> Name </t></is></c><c r="C3" t="inlineStr"><is><t>COUNT</t></is></c><c r="D3" t="inlineStr"><is><t>count</t></is></c></row>` +
+	`<row r="4"><c r="A4" t="inlineStr"><is><t>apple</t></is></c><c r="C4"><v>3</v></c><c r="D4"><v>4</v></c></row>` +
+	`</sheetData>`
+
+func TestReadHeaderAuto(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(headerTestSheet),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	err = sheet.ReadHeader(HeaderOptions{Row: AutoHeaderRow, TrimSpace: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{" Name ", "", "COUNT", "count"}, sheet.Header())
+
+	col, err := sheet.HeaderColumn("Name")
+	require.NoError(t, err)
+	require.Equal(t, 0, col)
+
+	_, err = sheet.HeaderColumn("Price")
+	require.ErrorIs(t, err, ErrHeaderNotFound)
+
+	require.True(t, sheet.NextRow())
+	val, err := sheet.HeaderValue("count")
+	require.NoError(t, err)
+	require.Equal(t, "4", val)
+	val, err = sheet.HeaderValue("Name")
+	require.NoError(t, err)
+	require.Equal(t, "apple", val)
+}
+
+func TestReadHeaderOptions(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(headerTestSheet),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	err = sheet.ReadHeader(HeaderOptions{Row: 2, IgnoreCase: true})
+	require.ErrorIs(t, err, ErrDuplicateHeader)
+
+	sheet, err = xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	err = sheet.ReadHeader(HeaderOptions{Row: 2, IgnoreCase: true, AllowDuplicates: true, Required: []string{"Price"}})
+	require.ErrorIs(t, err, ErrHeaderNotFound)
+	require.Contains(t, err.Error(), "Price")
+
+	sheet, err = xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	err = sheet.ReadHeader(HeaderOptions{Row: 0})
+	require.ErrorIs(t, err, ErrHeaderNotFound)
+
+	sheet, err = xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	err = sheet.ReadHeader(HeaderOptions{Row: 2, IgnoreCase: true, AllowDuplicates: true})
+	require.NoError(t, err)
+
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextCell())
+	_, err = sheet.HeaderValue("count")
+	require.ErrorIs(t, err, ErrRowConsumed)
+}
+
+func TestHeaderValueError(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="A1" t="inlineStr"><is><t>Name</t></is></c><c r="B1" t="inlineStr"><is><t>Count</t></is></c></row>` +
+			`<row r="2"><c r="A2" t="s"><v>x</v></c><c r="B2"><v>1</v></c></row>` +
+			`<row r="3"><c r="A3" t="inlineStr"><is><t>pear</t></is></c><c r="B3"><v>2</v></c></row>` +
+			`</sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	err = sheet.ReadHeader(HeaderOptions{Row: 0})
+	require.NoError(t, err)
+
+	require.True(t, sheet.NextRow())
+	_, err = sheet.HeaderValue("Count")
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+	_, err = sheet.HeaderValue("Name")
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+
+	require.True(t, sheet.NextRow())
+	val, err := sheet.HeaderValue("Name")
+	require.NoError(t, err)
+	require.Equal(t, "pear", val)
+}
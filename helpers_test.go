@@ -0,0 +1,51 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testWorkbookRels = `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`
+	testWorkbook = `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`
+)
+
+// testWorksheet wraps sheet content into a worksheet part.
+func testWorksheet(content string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		content + `</worksheet>`
+}
+
+// newTestXlsx builds an in-memory xlsx file from the given parts. The workbook
+// and its relationships default to a single worksheet xl/worksheets/sheet1.xml.
+func newTestXlsx(t *testing.T, parts map[string]string) *Xlsx {
+	t.Helper()
+
+	if _, ok := parts["xl/workbook.xml"]; !ok {
+		parts["xl/workbook.xml"] = testWorkbook
+	}
+	if _, ok := parts["xl/_rels/workbook.xml.rels"]; !ok {
+		parts["xl/_rels/workbook.xml.rels"] = testWorkbookRels
+	}
+
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	br := bytes.NewReader(buf.Bytes())
+	xlsx, err := New(br, br.Size())
+	require.NoError(t, err)
+	return xlsx
+}
@@ -0,0 +1,139 @@
+package xlsx
+
+import "strings"
+
+// Hyperlink is a link attached to a cell or a range of cells. External links
+// have URL set, links within the workbook have Location, which is also split
+// into Sheet and Cell when it refers to a cell rather than a defined name.
+type Hyperlink struct {
+	Range    Range
+	URL      string
+	Location string
+	Sheet    string
+	Cell     string
+	Tooltip  string
+	Display  string
+}
+
+type hyperlinkIndex struct {
+	cells  map[int]int
+	ranges []int
+}
+
+func (s *Sheet) resolveHyperlinks(tail *sheetTail) error {
+	if len(tail.hyperlinks) == 0 {
+		return nil
+	}
+
+	var (
+		rels       map[string]relationship
+		relsLoaded bool
+	)
+	for i := range tail.hyperlinks {
+		h := &tail.hyperlinks[i]
+		if rid := tail.hyperlinkRIDs[i]; rid != "" {
+			if !relsLoaded {
+				var err error
+				rels, err = s.x.partRels(s.file.Name)
+				if err != nil {
+					return err
+				}
+				relsLoaded = true
+			}
+			if rel, ok := rels[rid]; ok && rel.Type == relTypeHyperlink {
+				if rel.isExternal() {
+					h.URL = rel.Target
+				} else {
+					h.URL = resolveTarget(s.file.Name, rel.Target)
+				}
+			}
+			continue
+		}
+
+		h.Sheet, h.Cell = splitLocation(h.Location)
+		if h.Sheet == "" && h.Cell != "" {
+			h.Sheet = s.name
+		}
+	}
+
+	return nil
+}
+
+// splitLocation splits Sheet1!A1 style locations. A location that is
+// not a cell reference, like a defined name, yields empty parts.
+func splitLocation(location string) (string, string) {
+	location = strings.TrimPrefix(location, "#")
+
+	sheet := ""
+	cell := location
+	if strings.HasPrefix(location, "'") {
+		end := skipQuoted(location, 0)
+		if end >= len(location) || location[end] != '!' {
+			return "", ""
+		}
+		sheet = strings.ReplaceAll(location[1:end-1], "''", "'")
+		cell = location[end+1:]
+	} else if i := strings.LastIndexByte(location, '!'); i >= 0 {
+		sheet = location[:i]
+		cell = location[i+1:]
+		if strings.ContainsAny(sheet, "!,") {
+			return "", ""
+		}
+	}
+
+	if _, err := ParseRange(cell); err != nil {
+		return "", ""
+	}
+	return sheet, cell
+}
+
+// Hyperlinks returns all hyperlinks of the sheet.
+func (s *Sheet) Hyperlinks() ([]Hyperlink, error) {
+	tail, err := s.loadTail()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Hyperlink, len(tail.hyperlinks))
+	copy(result, tail.hyperlinks)
+	return result, nil
+}
+
+// CellHyperlink returns the hyperlink of the current cell or nil.
+func (s *Sheet) CellHyperlink() (*Hyperlink, error) {
+	tail, err := s.loadTail()
+	if err != nil {
+		return nil, err
+	}
+
+	if tail.hyperlinkIndex == nil {
+		index := &hyperlinkIndex{
+			cells: make(map[int]int),
+		}
+		for i, h := range tail.hyperlinks {
+			r := h.Range
+			if r.FirstRow == r.LastRow && r.FirstCol == r.LastCol {
+				key := r.FirstRow*maxColumns + r.FirstCol
+				if _, ok := index.cells[key]; !ok {
+					index.cells[key] = i
+				}
+			} else {
+				index.ranges = append(index.ranges, i)
+			}
+		}
+		tail.hyperlinkIndex = index
+	}
+
+	if i, ok := tail.hyperlinkIndex.cells[s.Row*maxColumns+s.Col]; ok {
+		h := tail.hyperlinks[i]
+		return &h, nil
+	}
+	for _, i := range tail.hyperlinkIndex.ranges {
+		if tail.hyperlinks[i].Range.Contains(s.Row, s.Col) {
+			h := tail.hyperlinks[i]
+			return &h, nil
+		}
+	}
+
+	return nil, nil
+}
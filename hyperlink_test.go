@@ -0,0 +1,57 @@
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHyperlinks(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="A1" t="inlineStr"><is><t>site</t></is></c><c r="B1" t="inlineStr"><is><t>jump</t></is></c></row>` +
+			`<row r="2"><c r="A2" t="inlineStr"><is><t>name</t></is></c><c r="B2"><v>1</v></c><c r="C2"><v>2</v></c></row>` +
+			`</sheetData><mergeCells count="1"><mergeCell ref="B2:C2"/></mergeCells><hyperlinks>` +
+			`<hyperlink ref="A1" r:id="rId1" tooltip="Open site" display="site"/>` +
+			`<hyperlink ref="B1" location="'My ''Data'''!B2"/>` +
+			`<hyperlink ref="A2" location="Prices"/>` +
+			`<hyperlink ref="B2:C2" location="C5"/>` +
+			`<hyperlink ref="D1" r:id="rId2"/>` +
+			`</hyperlinks>`),
+		"xl/worksheets/_rels/sheet1.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink" Target="https://example.com/item?id=1" TargetMode="External"/>` +
+			`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/comments" Target="../comments1.xml"/></Relationships>`,
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	links, err := sheet.Hyperlinks()
+	require.NoError(t, err)
+	require.Equal(t, []Hyperlink{
+		{Range: Range{}, URL: "https://example.com/item?id=1", Tooltip: "Open site", Display: "site"},
+		{Range: Range{FirstCol: 1, LastCol: 1}, Location: "'My ''Data'''!B2", Sheet: "My 'Data'", Cell: "B2"},
+		{Range: Range{FirstRow: 1, LastRow: 1}, Location: "Prices"},
+		{Range: Range{FirstRow: 1, FirstCol: 1, LastRow: 1, LastCol: 2}, Location: "C5", Sheet: "Sheet1", Cell: "C5"},
+		{Range: Range{FirstCol: 3, LastCol: 3}},
+	}, links)
+
+	var found []string
+	for sheet.NextRow() {
+		for sheet.NextCell() {
+			link, er := sheet.CellHyperlink()
+			require.NoError(t, er)
+			if link != nil {
+				found = append(found, cellName(sheet.Col, sheet.Row)+"="+link.URL+link.Location)
+			}
+		}
+	}
+	require.Equal(t, []string{
+		"A1=https://example.com/item?id=1",
+		"B1='My ''Data'''!B2",
+		"A2=Prices",
+		"B2=C5",
+		"C2=C5",
+	}, found)
+}
@@ -0,0 +1,125 @@
+package xlsx
+
+import (
+	"errors"
+	"io"
+	"iter"
+	"time"
+)
+
+// Row is the row the sheet cursor is positioned on during Sheet.Rows.
+type Row struct {
+	sheet *Sheet
+	Index int
+}
+
+// Cell is a view of the cell the sheet cursor is positioned on.
+// It is valid only until the cursor moves to the next cell.
+type Cell struct {
+	sheet *Sheet
+	Row   int
+	Col   int
+}
+
+// Rows returns an iterator over the remaining rows of the sheet. A read error
+// is yielded once as the last element. The sheet is closed when the loop ends,
+// including on break.
+func (s *Sheet) Rows() iter.Seq2[Row, error] {
+	return func(yield func(Row, error) bool) {
+		defer s.Close()
+
+		for s.NextRow() {
+			if !yield(Row{sheet: s, Index: s.Row}, nil) {
+				return
+			}
+		}
+
+		if !errors.Is(s.err, io.EOF) {
+			yield(Row{}, s.err)
+		}
+	}
+}
+
+// Cells returns an iterator over the remaining cells of the row.
+// Read errors are reported by Sheet.Rows or Sheet.Err.
+func (r Row) Cells() iter.Seq[Cell] {
+	return func(yield func(Cell) bool) {
+		if r.sheet.Row != r.Index {
+			return
+		}
+
+		for r.sheet.NextCell() {
+			if !yield(Cell{sheet: r.sheet, Row: r.sheet.Row, Col: r.sheet.Col}) {
+				return
+			}
+		}
+	}
+}
+
+// Decode reads the remaining cells of the row into dst, see Sheet.DecodeRow.
+func (r Row) Decode(dst any) error {
+	return r.sheet.DecodeRow(dst)
+}
+
+func (c Cell) Type() CellType {
+	return c.sheet.CellType()
+}
+
+// TypedValue returns the cell value as a Go value, see Sheet.Value.
+func (c Cell) TypedValue() (any, error) {
+	return c.sheet.Value()
+}
+
+func (c Cell) Value() (string, error) {
+	return c.sheet.CellValue()
+}
+
+func (c Cell) FormatValue() (string, error) {
+	return c.sheet.CellFormatValue()
+}
+
+func (c Cell) Int() (int, error) {
+	return c.sheet.CellInt()
+}
+
+func (c Cell) Float() (float64, error) {
+	return c.sheet.CellFloat()
+}
+
+func (c Cell) Time() (time.Time, error) {
+	return c.sheet.CellTime()
+}
+
+func (c Cell) TimeIn(loc *time.Location) (time.Time, error) {
+	return c.sheet.CellTimeIn(loc)
+}
+
+func (c Cell) Duration() (time.Duration, error) {
+	return c.sheet.CellDuration()
+}
+
+func (c Cell) Formula() (Formula, error) {
+	return c.sheet.CellFormula()
+}
+
+// Sheets returns an iterator over all worksheets of the workbook in order.
+// Each sheet is closed when the loop body moves on to the next one or exits.
+func (x *Xlsx) Sheets(opts ...SheetOption) iter.Seq2[*Sheet, error] {
+	return func(yield func(*Sheet, error) bool) {
+		for n := range x.sheetFile {
+			sheet, err := x.OpenSheetByOrder(n, opts...)
+			if err != nil {
+				if !yield(nil, err) {
+					return
+				}
+				continue
+			}
+
+			ok := yield(sheet, nil)
+			_ = sheet.Close()
+			if !ok {
+				return
+			}
+		}
+	}
+}
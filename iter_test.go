@@ -0,0 +1,90 @@
+package xlsx
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowsIterator(t *testing.T) {
+	data, err := os.ReadFile("testdata/multi_row.xlsx")
+	require.NoError(t, err)
+
+	br := bytes.NewReader(data)
+	xlsx, err := New(br, br.Size())
+	require.NoError(t, err)
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+
+	var rows [][]string
+	for row, err := range sheet.Rows() {
+		require.NoError(t, err)
+
+		var values []string
+		for cell := range row.Cells() {
+			require.Equal(t, row.Index, cell.Row)
+			require.Equal(t, len(values), cell.Col)
+
+			val, er := cell.Value()
+			require.NoError(t, er)
+			values = append(values, val)
+		}
+		rows = append(rows, values)
+	}
+
+	require.Equal(t, [][]string{
+		{"Text", "Id", "Name", "Count", "Fill"},
+		{"Some text", "1245237", "something", "5", "Filled"},
+	}, rows)
+	require.True(t, sheet.closed)
+}
+
+func TestRowsIteratorBreak(t *testing.T) {
+	data, err := os.ReadFile("testdata/test1.xlsx")
+	require.NoError(t, err)
+
+	br := bytes.NewReader(data)
+	xlsx, err := New(br, br.Size())
+	require.NoError(t, err)
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+
+	for row, err := range sheet.Rows() {
+		require.NoError(t, err)
+		require.Equal(t, 0, row.Index)
+		break
+	}
+	require.True(t, sheet.closed)
+	require.NoError(t, sheet.Close())
+}
+
+func TestSheetsIterator(t *testing.T) {
+	data, err := os.ReadFile("testdata/test1.xlsx")
+	require.NoError(t, err)
+
+	br := bytes.NewReader(data)
+	xlsx, err := New(br, br.Size())
+	require.NoError(t, err)
+
+	var names []string
+	var sheets []*Sheet
+	for sheet, err := range xlsx.Sheets() {
+		require.NoError(t, err)
+		names = append(names, sheet.Name())
+		sheets = append(sheets, sheet)
+	}
+	require.Equal(t, []string{"Sheet1", "Sheet2"}, names)
+	for _, sheet := range sheets {
+		require.True(t, sheet.closed)
+	}
+
+	for sheet, err := range xlsx.Sheets() {
+		require.NoError(t, err)
+		require.Equal(t, "Sheet1", sheet.Name())
+		break
+	}
+}
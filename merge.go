@@ -0,0 +1,190 @@
+package xlsx
+
+import (
+	"errors"
+	"io"
+	"slices"
+)
+
+type mergeState struct {
+	fill   bool
+	ranges []Range
+	next   int
+	active []int
+	// current is the index of the range holding the current cell or -1.
+	current int
+
+	values     map[int]*savedCell
+	synth      []int
+	pending    savedCell
+	hasPending bool
+	rowEnd     bool
+}
+
+// savedCell is a copy of the cell state used to replay cells.
+type savedCell struct {
+	col     int
+	value   []byte
+	typ     CellType
+	format  int
+	formula cellFormula
+	runs    []RichTextRun
+}
+
+func (s *Sheet) initMerge(fill bool) error {
+	tail, err := s.loadTail()
+	if err != nil {
+		return err
+	}
+
+	ranges := slices.Clone(tail.mergedRanges)
+	slices.SortStableFunc(ranges, func(a, b Range) int {
+		return a.FirstRow - b.FirstRow
+	})
+
+	s.merge = &mergeState{
+		fill:    fill,
+		ranges:  ranges,
+		current: -1,
+		values:  make(map[int]*savedCell),
+	}
+	return nil
+}
+
+// CellMergedRange returns the merged range the current cell belongs to.
+// It requires the sheet to be opened with WithMergedCells or WithMergedCellValues.
+func (s *Sheet) CellMergedRange() (Range, bool) {
+	if s.merge == nil || s.merge.current < 0 {
+		return Range{}, false
+	}
+	return s.merge.ranges[s.merge.current], true
+}
+
+func (m *mergeState) startRow(row int) {
+	m.active = slices.DeleteFunc(m.active, func(i int) bool {
+		if m.ranges[i].LastRow < row {
+			delete(m.values, i)
+			return true
+		}
+		return false
+	})
+	for ; m.next < len(m.ranges) && m.ranges[m.next].FirstRow <= row; m.next++ {
+		if m.ranges[m.next].LastRow >= row {
+			m.active = append(m.active, m.next)
+		}
+	}
+
+	m.current = -1
+	m.hasPending = false
+	m.rowEnd = false
+	m.synth = m.synth[:0]
+	if !m.fill {
+		return
+	}
+
+	for _, i := range m.active {
+		r := m.ranges[i]
+		for col := r.FirstCol; col <= r.LastCol; col++ {
+			if row != r.FirstRow || col != r.FirstCol {
+				m.synth = append(m.synth, col)
+			}
+		}
+	}
+	slices.Sort(m.synth)
+}
+
+func (m *mergeState) find(row, col int) int {
+	for _, i := range m.active {
+		if m.ranges[i].Contains(row, col) {
+			return i
+		}
+	}
+	return -1
+}
+
+// nextMergedCell is NextCell for WithMergedCellValues. Real cells are read one
+// ahead so covered cells missing from the sheet can be produced in order.
+func (s *Sheet) nextMergedCell() bool {
+	m := s.merge
+	if !m.hasPending && !m.rowEnd {
+		if s.nextCell() {
+			s.saveCell(&m.pending)
+			m.hasPending = true
+		} else {
+			if s.err != nil && !errors.Is(s.err, io.EOF) {
+				return false
+			}
+			m.rowEnd = true
+		}
+	}
+
+	for len(m.synth) > 0 && m.hasPending && m.synth[0] == m.pending.col {
+		m.synth = m.synth[1:]
+	}
+
+	if len(m.synth) > 0 && (!m.hasPending || m.synth[0] < m.pending.col) {
+		s.Col = m.synth[0]
+		m.synth = m.synth[1:]
+		s.cellValue = s.cellValue[:0]
+		s.cellType = CellTypeNumeric
+		s.cellFormat = 0
+		s.cellRuns = s.cellRuns[:0]
+		s.formula.reset()
+		s.applyMerge()
+		return true
+	}
+
+	if m.hasPending {
+		m.hasPending = false
+		s.restoreCell(&m.pending)
+		s.applyMerge()
+		return true
+	}
+
+	return false
+}
+
+func (s *Sheet) applyMerge() {
+	m := s.merge
+	m.current = m.find(s.Row, s.Col)
+	if m.current < 0 || !m.fill {
+		return
+	}
+
+	r := m.ranges[m.current]
+	if s.Row == r.FirstRow && s.Col == r.FirstCol {
+		c, ok := m.values[m.current]
+		if !ok {
+			c = &savedCell{}
+			m.values[m.current] = c
+		}
+		s.saveCell(c)
+		return
+	}
+
+	if c, ok := m.values[m.current]; ok {
+		s.cellValue = append(s.cellValue[:0], c.value...)
+		s.cellType = c.typ
+		s.cellFormat = c.format
+		s.cellRuns = append(s.cellRuns[:0], c.runs...)
+		s.formula.reset()
+	}
+}
+
+func (s *Sheet) saveCell(c *savedCell) {
+	c.col = s.Col
+	c.value = append(c.value[:0], s.cellValue...)
+	c.typ = s.cellType
+	c.format = s.cellFormat
+	c.runs = append(c.runs[:0], s.cellRuns...)
+	c.formula.copyFrom(&s.formula)
+}
+
+func (s *Sheet) restoreCell(c *savedCell) {
+	s.Col = c.col
+	s.cellValue = append(s.cellValue[:0], c.value...)
+	s.cellType = c.typ
+	s.cellFormat = c.format
+	s.cellRuns = append(s.cellRuns[:0], c.runs...)
+	s.formula.copyFrom(&c.formula)
+}
@@ -0,0 +1,90 @@
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const mergeTestSheet = `<sheetData>` +
+	`<row r="1"><c r="A1" t="inlineStr"><is><t>Fruit</t></is></c><c r="B1" t="inlineStr"><is><t>apple</t></is></c><c r="C1" t="inlineStr"><is><t>Total</t></is></c></row>` +
+	`<row r="2"><c r="A2" s="1"/><c r="B2" t="inlineStr"><is><t>pear</t></is></c></row>` +
+	`<row r="3"><c r="B3" t="inlineStr"><is><t>plum</t></is></c></row>` +
+	`</sheetData>` +
+	`<mergeCells count="2"><mergeCell ref="A1:A3"/><mergeCell ref="C1:D1"/></mergeCells>`
+
+func TestMergedRanges(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(mergeTestSheet),
+	})
+
+	expected := []Range{
+		{FirstRow: 0, FirstCol: 0, LastRow: 2, LastCol: 0},
+		{FirstRow: 0, FirstCol: 2, LastRow: 0, LastCol: 3},
+	}
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	ranges, err := sheet.MergedRanges()
+	require.NoError(t, err)
+	require.Equal(t, expected, ranges)
+
+	sheet, err = xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	for sheet.NextRow() {
+		for sheet.NextCell() {
+		}
+	}
+	ranges, err = sheet.MergedRanges()
+	require.NoError(t, err)
+	require.Equal(t, expected, ranges)
+}
+
+func TestMergedCells(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(mergeTestSheet),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0, WithMergedCells())
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	var cells []string
+	for sheet.NextRow() {
+		for sheet.NextCell() {
+			r, ok := sheet.CellMergedRange()
+			if ok {
+				cells = append(cells, cellName(sheet.Col, sheet.Row)+"="+r.String())
+			}
+		}
+	}
+	require.Equal(t, []string{"A1=A1:A3", "C1=C1:D1", "A2=A1:A3"}, cells)
+}
+
+func TestMergedCellValues(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(mergeTestSheet),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0, WithMergedCellValues())
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	var cells []string
+	for sheet.NextRow() {
+		for sheet.NextCell() {
+			val, er := sheet.CellValue()
+			require.NoError(t, er)
+			cells = append(cells, cellName(sheet.Col, sheet.Row)+"="+val)
+		}
+	}
+	require.Equal(t, []string{
+		"A1=Fruit", "B1=apple", "C1=Total", "D1=Total",
+		"A2=Fruit", "B2=pear",
+		"A3=Fruit", "B3=plum",
+	}, cells)
+}
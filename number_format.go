@@ -3,7 +3,6 @@ package xlsx
 // Most of this file was taken from https://github.com/tealeg/xlsx
 
 import (
-	"fmt"
 	"math"
 	"strconv"
 	"strings"
@@ -46,6 +45,8 @@ var builtinNumFormats = []string{
 	49: "@",
 }
 
+var generalNumFormat = parseFullNumberFormatString("general")
+
 type parsedNumFormat struct {
 	numFmt                        string
 	positiveFormat                *formatOptions
@@ -55,20 +56,20 @@ type parsedNumFormat struct {
 	parseEncounteredError         error
 	isTimeFormat                  bool
 	negativeFormatExpectsPositive bool
+	kind                          NumberFormatKind
 }
 
 type formatOptions struct {
 	fullFormatString    string
 	reducedFormatString string
-	prefix              string
-	suffix              string
 	isTimeFormat        bool
-	showPercent         bool
+	number              *numberSection
 }
 
 func parseFullNumberFormatString(numFmt string) *parsedNumFormat {
 	parsedNumFmt := &parsedNumFormat{
 		numFmt: numFmt,
+		kind:   ClassifyNumberFormat(numFmt),
 	}
 
 	var fmtOptions []*formatOptions
@@ -173,28 +174,15 @@ func parseNumberFormatSection(fullFormat string) (*formatOptions, error) {
 		}, nil
 	}
 
-	prefix, reducedFormat, showPercent1, err := parseLiterals(reducedFormat)
+	number, err := parseNumberSection(reducedFormat)
 	if err != nil {
 		return nil, err
 	}
 
-	reducedFormat, suffixFormat := splitFormatAndSuffixFormat(reducedFormat)
-
-	suffix, remaining, showPercent2, err := parseLiterals(suffixFormat)
-	if err != nil {
-		return nil, err
-	}
-	if len(remaining) > 0 {
-		return nil, ErrInvalidFormat
-	}
-
 	return &formatOptions{
 		fullFormatString:    fullFormat,
-		isTimeFormat:        false,
 		reducedFormatString: reducedFormat,
-		prefix:              prefix,
-		suffix:              suffix,
-		showPercent:         showPercent1 || showPercent2,
+		number:              number,
 	}, nil
 }
 
@@ -272,98 +260,14 @@ var timeFormatCharacters = []string{
 	"s.0000", "s.000", "s.00", "s.0", "s", "[ss].0000", "[ss].000", "[ss].00", "[ss].0", "[ss]", "[s].0000", "[s].000", "[s].00", "[s].0", "[s]", "上", "午", "下",
 }
 
-func parseLiterals(format string) (string, string, bool, error) {
-	var prefix string
-	showPercent := false
-	for i := 0; i < len(format); i++ {
-		curReducedFormat := format[i:]
-		switch curReducedFormat[0] {
-		case '\\':
-			if len(curReducedFormat) > 1 {
-				i++
-				prefix += curReducedFormat[1:2]
-			}
-		case '_':
-			if len(curReducedFormat) > 1 {
-				i++
-			}
-		case '*':
-		case '"':
-			endQuoteIndex := strings.Index(curReducedFormat[1:], "\"")
-			if endQuoteIndex == -1 {
-				return "", "", false, ErrDoubleQuote
-			}
-			prefix = prefix + curReducedFormat[1:endQuoteIndex+1]
-			i += endQuoteIndex + 1
-		case '%':
-			showPercent = true
-			prefix += "%"
-		case '[':
-			bracketIndex := strings.Index(curReducedFormat, "]")
-			if bracketIndex == -1 {
-				return "", "", false, ErrInvalidBrackets
-			}
-			if len(curReducedFormat) > 2 && curReducedFormat[1] == '$' {
-				dashIndex := strings.Index(curReducedFormat, "-")
-				if dashIndex != -1 && dashIndex < bracketIndex {
-					prefix += curReducedFormat[2:dashIndex]
-				} else {
-					return "", "", false, ErrInvalidCurrency
-				}
-			}
-			if curReducedFormat[1] == '=' || curReducedFormat[1] == '>' || curReducedFormat[1] == '<' {
-				return "", "", false, fmt.Errorf("unsupported formatting code: %s", format)
-			}
-			i += bracketIndex
-		case '$', '-', '+', '/', '(', ')', ':', '!', '^', '&', '\'', '~', '{', '}', '<', '>', '=', ' ':
-			prefix += curReducedFormat[0:1]
-		default:
-			for _, special := range formattingCharacters {
-				if strings.HasPrefix(curReducedFormat, special) {
-					return prefix, format[i:], showPercent, nil
-				}
-			}
-			return "", "", false, EUnsupportedCharacters
-		}
-	}
-	return prefix, "", showPercent, nil
-}
-
-var formattingCharacters = []string{"0/", "#/", "?/", "E-", "E+", "e-", "e+", "0", "#", "?", ".", ",", "@", "*"}
-
-func splitFormatAndSuffixFormat(format string) (string, string) {
-	var i int
-	for ; i < len(format); i++ {
-		curReducedFormat := format[i:]
-		var found bool
-		for _, special := range formattingCharacters {
-			if strings.HasPrefix(curReducedFormat, special) {
-				i += len(special) - 1
-				found = true
-				break
-			}
-		}
-		if !found {
-			break
-		}
-	}
-	suffixFormat := format[i:]
-	format = format[:i]
-	return format, suffixFormat
-}
-
+// text formats a text value. A text section without @ shows only its
+// literals, number sections show the text as is.
 func (p *parsedNumFormat) text(value string) (string, error) {
-	textFormat := p.textFormat
-	switch textFormat.reducedFormatString {
-	case "general":
+	number := p.textFormat.number
+	if number == nil || (!number.hasText && number.intDigits+number.fracDigits > 0) {
 		return value, nil
-	case "@":
-		return textFormat.prefix + value + textFormat.suffix, nil
-	case "":
-		return textFormat.prefix + textFormat.suffix, nil
-	default:
-		return value, ErrInvalidFormat
 	}
+	return number.formatText(value), nil
 }
 
 func (p *parsedNumFormat) numeric(value string, date1904 bool) (string, error) {
@@ -372,61 +276,36 @@ func (p *parsedNumFormat) numeric(value string, date1904 bool) (string, error) {
 		return "", nil
 	}
 
-	if p.isTimeFormat {
-		return p.parseTime(rawValue, date1904)
-	}
-	var numberFormat *formatOptions
 	floatVal, floatErr := strconv.ParseFloat(rawValue, 64)
 	if floatErr != nil {
 		return rawValue, floatErr
 	}
+	// ParseFloat accepts NaN and Inf, which no section can format.
+	if math.IsNaN(floatVal) || math.IsInf(floatVal, 0) {
+		return strconv.FormatFloat(floatVal, 'f', -1, 64), nil
+	}
+
+	if p.isTimeFormat {
+		return p.parseTime(rawValue, date1904)
+	}
 
+	// A single section formats negative values with a minus sign, otherwise
+	// the negative section has its own signs.
+	var numberFormat *formatOptions
+	negative := false
 	if floatVal > 0 {
 		numberFormat = p.positiveFormat
 	} else if floatVal < 0 {
-		if p.negativeFormatExpectsPositive {
-			floatVal = math.Abs(floatVal)
-		}
 		numberFormat = p.negativeFormat
+		negative = !p.negativeFormatExpectsPositive
 	} else {
 		numberFormat = p.zeroFormat
 	}
 
-	if numberFormat.showPercent {
-		floatVal = 100 * floatVal
-	}
-
-	var formattedNum string
-	switch numberFormat.reducedFormatString {
-	case "general":
-		generalFormatted, err := generalNumericScientific(value, true)
-		if err != nil {
-			return rawValue, nil
-		}
-		return generalFormatted, nil
-	case "@":
-		formattedNum = value
-	case "0", "#,##0":
-		formattedNum = fmt.Sprintf("%.0f", floatVal)
-	case "0.0", "#,##0.0":
-		formattedNum = fmt.Sprintf("%.1f", floatVal)
-	case "0.00", "#,##0.00":
-		formattedNum = fmt.Sprintf("%.2f", floatVal)
-	case "0.000", "#,##0.000":
-		formattedNum = fmt.Sprintf("%.3f", floatVal)
-	case "0.0000", "#,##0.0000":
-		formattedNum = fmt.Sprintf("%.4f", floatVal)
-	case "0.00e+00", "##0.0e+0":
-		formattedNum = fmt.Sprintf("%e", floatVal)
-	case "":
-		//
-	default:
-		if cntZeros := strings.Count(numberFormat.reducedFormatString, "0"); cntZeros == len(numberFormat.reducedFormatString) && cntZeros > len(rawValue) {
-			return numberFormat.reducedFormatString[:cntZeros-len(rawValue)] + rawValue, nil
-		}
-		return rawValue, nil
+	if numberFormat.number == nil {
+		return generalNumericScientific(rawValue, true)
 	}
-	return numberFormat.prefix + formattedNum + numberFormat.suffix, nil
+	return numberFormat.number.format(floatVal, negative), nil
 }
 
 var timeReplacements = []struct{ xltime, gotime string }{
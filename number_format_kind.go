@@ -0,0 +1,275 @@
+package xlsx
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NumberFormatKind is the category of a number format.
+type NumberFormatKind int
+
+const (
+	NumberFormatGeneral NumberFormatKind = iota
+	NumberFormatNumber
+	NumberFormatCurrency
+	NumberFormatAccounting
+	NumberFormatPercent
+	NumberFormatFraction
+	NumberFormatScientific
+	NumberFormatDate
+	NumberFormatTime
+	NumberFormatDateTime
+	NumberFormatDuration
+	NumberFormatText
+)
+
+func (k NumberFormatKind) String() string {
+	switch k {
+	case NumberFormatGeneral:
+		return "general"
+	case NumberFormatNumber:
+		return "number"
+	case NumberFormatCurrency:
+		return "currency"
+	case NumberFormatAccounting:
+		return "accounting"
+	case NumberFormatPercent:
+		return "percent"
+	case NumberFormatFraction:
+		return "fraction"
+	case NumberFormatScientific:
+		return "scientific"
+	case NumberFormatDate:
+		return "date"
+	case NumberFormatTime:
+		return "time"
+	case NumberFormatDateTime:
+		return "datetime"
+	case NumberFormatDuration:
+		return "duration"
+	case NumberFormatText:
+		return "text"
+	}
+	return "unknown"
+}
+
+// IsDate reports whether values of the format are dates or times of day.
+func (k NumberFormatKind) IsDate() bool {
+	return k == NumberFormatDate || k == NumberFormatTime || k == NumberFormatDateTime
+}
+
+// NumberFormat is the number format of a cell. ID is the numFmtId, built-in
+// formats have ids up to 163 and their code is filled in. Locale dependent
+// built-in formats missing from the file have the general code, but their
+// Kind is known.
+type NumberFormat struct {
+	ID   int
+	Code string
+	Kind NumberFormatKind
+}
+
+// localeNumFormatKinds are the kinds of the built-in formats whose code
+// depends on the locale and isn't stored in the file.
+var localeNumFormatKinds = map[int]NumberFormatKind{
+	5: NumberFormatCurrency, 6: NumberFormatCurrency, 7: NumberFormatCurrency, 8: NumberFormatCurrency,
+	27: NumberFormatDate, 28: NumberFormatDate, 29: NumberFormatDate, 30: NumberFormatDate, 31: NumberFormatDate,
+	32: NumberFormatTime, 33: NumberFormatTime, 34: NumberFormatTime, 35: NumberFormatTime,
+	36: NumberFormatDate,
+	50: NumberFormatDate, 51: NumberFormatDate, 52: NumberFormatDate, 53: NumberFormatDate, 54: NumberFormatDate,
+	55: NumberFormatTime, 56: NumberFormatTime,
+	57: NumberFormatDate, 58: NumberFormatDate,
+}
+
+// currencySymbols are the currency signs recognized outside of [$] blocks.
+const currencySymbols = "$€£¥₽₹₩₪₺₴₫฿¢"
+
+// ClassifyNumberFormat returns the kind of a format code. The first section
+// decides the kind, colors, conditions and locale blocks are ignored.
+func ClassifyNumberFormat(code string) NumberFormatKind {
+	sections, err := splitFormat(code)
+	if err != nil || len(sections) == 0 {
+		return NumberFormatGeneral
+	}
+
+	section := strings.TrimSpace(sections[0])
+	if section == "" || strings.EqualFold(section, "general") {
+		return NumberFormatGeneral
+	}
+
+	var (
+		date, clock, elapsed     bool
+		digits, text, percent    bool
+		fill, currency, exponent bool
+		fraction                 bool
+		lastDigit                bool
+		afterHour                bool
+	)
+	runes := []rune(section)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '"':
+			j := i + 1
+			for j < len(runes) && runes[j] != '"' {
+				if strings.ContainsRune(currencySymbols, runes[j]) {
+					currency = true
+				}
+				j++
+			}
+			i = j
+		case r == '\\':
+			if i+1 < len(runes) && strings.ContainsRune(currencySymbols, runes[i+1]) {
+				currency = true
+			}
+			i++
+		case r == '_':
+			i++
+		case r == '*':
+			fill = true
+			i++
+		case r == '[':
+			j := i + 1
+			for j < len(runes) && runes[j] != ']' {
+				j++
+			}
+			block := strings.ToLower(string(runes[i+1 : min(j, len(runes))]))
+			switch {
+			case strings.HasPrefix(block, "$"):
+				symbol, _, _ := strings.Cut(block[1:], "-")
+				if symbol != "" {
+					currency = true
+				}
+			case block != "" && strings.Trim(block, "hms") == "":
+				elapsed = true
+			}
+			i = j
+		case r == '@':
+			text = true
+		case r == '%':
+			percent = true
+		case r == '0' || r == '#' || r == '?':
+			digits = true
+			lastDigit = true
+			continue
+		case r == '/':
+			if lastDigit && i+1 < len(runes) && strings.ContainsRune("0123456789#?", runes[i+1]) {
+				fraction = true
+			}
+		case (r == 'E' || r == 'e') && digits && i+1 < len(runes) && (runes[i+1] == '+' || runes[i+1] == '-'):
+			exponent = true
+			i++
+		case strings.ContainsRune(currencySymbols, r):
+			currency = true
+		case hasFoldPrefix(runes[i:], "general"):
+			i += 6
+		case hasFoldPrefix(runes[i:], "am/pm"):
+			clock = true
+			i += 4
+		case hasFoldPrefix(runes[i:], "a/p"):
+			clock = true
+			i += 2
+		default:
+			switch unicode.ToLower(r) {
+			case 'y', 'd', 'e', 'g', 'b':
+				date = true
+				afterHour = false
+			case 'h':
+				clock = true
+				afterHour = true
+			case 's':
+				clock = true
+			case 'm':
+				if afterHour || minuteFollows(runes[i:]) {
+					clock = true
+				} else {
+					date = true
+				}
+				for i+1 < len(runes) && unicode.ToLower(runes[i+1]) == 'm' {
+					i++
+				}
+				afterHour = false
+			}
+		}
+		if r != ' ' && r != ',' && r != '.' {
+			lastDigit = false
+		}
+	}
+
+	switch {
+	case elapsed:
+		return NumberFormatDuration
+	case date && clock:
+		return NumberFormatDateTime
+	case date:
+		return NumberFormatDate
+	case clock:
+		return NumberFormatTime
+	case text && !digits:
+		return NumberFormatText
+	case !digits:
+		return NumberFormatGeneral
+	case exponent:
+		return NumberFormatScientific
+	case fraction:
+		return NumberFormatFraction
+	case percent:
+		return NumberFormatPercent
+	case fill:
+		return NumberFormatAccounting
+	case currency:
+		return NumberFormatCurrency
+	}
+	return NumberFormatNumber
+}
+
+func hasFoldPrefix(runes []rune, prefix string) bool {
+	return len(runes) >= len(prefix) && strings.EqualFold(string(runes[:len(prefix)]), prefix)
+}
+
+// minuteFollows reports whether the m at the start of runes is followed by
+// seconds, which makes it minutes rather than month.
+func minuteFollows(runes []rune) bool {
+	for _, r := range runes[1:] {
+		switch unicode.ToLower(r) {
+		case 'm', ':', ' ', '.':
+			continue
+		case 's':
+			return true
+		}
+		return false
+	}
+	return false
+}
+
+// numberFormat resolves the number format of the cell format with the given
+// index.
+func (s *styleSheet) numberFormat(idx int) NumberFormat {
+	if s == nil {
+		return NumberFormat{Code: "general"}
+	}
+
+	id, code := s.getFormatCode(idx)
+	kind, ok := localeNumFormatKinds[id]
+	if !ok || s.numFormats[id] != "" {
+		kind = s.getFormat(idx).kind
+	}
+	return NumberFormat{ID: id, Code: code, Kind: kind}
+}
+
+// CellNumberFormat returns the number format of the current cell.
+func (s *Sheet) CellNumberFormat() NumberFormat {
+	return s.styles.numberFormat(s.cellFormat)
+}
+
+// CellIsDate reports whether the current cell holds a date or time: an ISO
+// 8601 date cell or a numeric cell with a date, time or datetime format.
+// Elapsed time formats like [h]:mm are durations, not dates.
+func (s *Sheet) CellIsDate() bool {
+	switch s.cellType {
+	case CellTypeDate:
+		return true
+	case CellTypeNumeric:
+		return s.CellNumberFormat().Kind.IsDate()
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package xlsx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyNumberFormat(t *testing.T) {
+	tests := []struct {
+		code string
+		want NumberFormatKind
+	}{
+		{"", NumberFormatGeneral},
+		{"General", NumberFormatGeneral},
+		{"[Red]General", NumberFormatGeneral},
+		{"0", NumberFormatNumber},
+		{"#,##0.00;[Red]-#,##0.00", NumberFormatNumber},
+		{`0.00" kg"`, NumberFormatNumber},
+		{`"$"#,##0.00`, NumberFormatCurrency},
+		{"[$€-407] #,##0.00", NumberFormatCurrency},
+		{"#,##0.00 ₽", NumberFormatCurrency},
+		{`_("$"* #,##0.00_);_("$"* \(#,##0.00\);_("$"* "-"??_);_(@_)`, NumberFormatAccounting},
+		{`_(* #,##0_);_(* \(#,##0\);_(* "-"_);_(@_)`, NumberFormatAccounting},
+		{"0%", NumberFormatPercent},
+		{"0.00%", NumberFormatPercent},
+		{"# ?/?", NumberFormatFraction},
+		{"# ??/16", NumberFormatFraction},
+		{"0.00E+00", NumberFormatScientific},
+		{"##0.0e+0", NumberFormatScientific},
+		{"mm-dd-yy", NumberFormatDate},
+		{"d-mmm-yy", NumberFormatDate},
+		{"[$-409]mmmm d, yyyy;@", NumberFormatDate},
+		{"h:mm AM/PM", NumberFormatTime},
+		{"mm:ss", NumberFormatTime},
+		{"h:mm:ss.000", NumberFormatTime},
+		{"m/d/yy h:mm", NumberFormatDateTime},
+		{"yyyy-mm-dd hh:mm:ss", NumberFormatDateTime},
+		{"[h]:mm:ss", NumberFormatDuration},
+		{"[mm]:ss", NumberFormatDuration},
+		{"@", NumberFormatText},
+		{`"Total: "@`, NumberFormatText},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, ClassifyNumberFormat(tt.code), tt.code)
+	}
+}
+
+func TestCellNumberFormat(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/styles.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<numFmts count="1"><numFmt numFmtId="164" formatCode="[h]:mm"/></numFmts>` +
+			`<cellXfs count="5"><xf numFmtId="0"/><xf numFmtId="14"/><xf numFmtId="164"/><xf numFmtId="31"/><xf numFmtId="10"/></cellXfs>` +
+			`</styleSheet>`,
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData><row r="1">` +
+			`<c r="A1"><v>1</v></c>` +
+			`<c r="B1" s="1"><v>45352</v></c>` +
+			`<c r="C1" s="2"><v>1.5</v></c>` +
+			`<c r="D1" s="3"><v>45352</v></c>` +
+			`<c r="E1" s="4"><v>0.25</v></c>` +
+			`<c r="F1" t="d"><v>2024-03-01</v></c>` +
+			`<c r="G1" s="1" t="inlineStr"><is><t>text</t></is></c>` +
+			`</row></sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	expected := []struct {
+		format NumberFormat
+		isDate bool
+	}{
+		{NumberFormat{ID: 0, Code: "general", Kind: NumberFormatGeneral}, false},
+		{NumberFormat{ID: 14, Code: "mm-dd-yy", Kind: NumberFormatDate}, true},
+		{NumberFormat{ID: 164, Code: "[h]:mm", Kind: NumberFormatDuration}, false},
+		{NumberFormat{ID: 31, Code: "general", Kind: NumberFormatDate}, true},
+		{NumberFormat{ID: 10, Code: "0.00%", Kind: NumberFormatPercent}, false},
+		{NumberFormat{ID: 0, Code: "general", Kind: NumberFormatGeneral}, true},
+		{NumberFormat{ID: 14, Code: "mm-dd-yy", Kind: NumberFormatDate}, false},
+	}
+
+	require.True(t, sheet.NextRow())
+	for _, exp := range expected {
+		require.True(t, sheet.NextCell())
+		require.Equal(t, exp.format, sheet.CellNumberFormat(), sheet.Col)
+		require.Equal(t, exp.isDate, sheet.CellIsDate(), sheet.Col)
+
+		value, err := sheet.Value()
+		require.NoError(t, err)
+		_, isTime := value.(time.Time)
+		require.Equal(t, exp.isDate, isTime, sheet.Col)
+	}
+}
@@ -0,0 +1,156 @@
+package xlsx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumberFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		value  string
+		want   string
+	}{
+		{"general", "1234.5", "1234.5"},
+		{"0", "0", "0"},
+		{"0", "2.5", "3"},
+		{"0", "-2.5", "-3"},
+		{"0", "1E+15", "1000000000000000"},
+		{"#", "0", ""},
+		{"0000", "12", "0012"},
+		{"0.00", "2.675", "2.68"},
+		{"0.00", "0.125", "0.13"},
+		{"0.00", "1.005", "1.01"},
+		{"0.00", "-0.001", "0.00"},
+		{"0.000", "1E-10", "0.000"},
+		{"0.0000", "3.14159", "3.1416"},
+		{"#,##0", "1234567", "1,234,567"},
+		{"#,##0", "-1234567", "-1,234,567"},
+		{"#,##0", "999.5", "1,000"},
+		{"#,##0", "12", "12"},
+		{"#,##0.00", "1234.5", "1,234.50"},
+		{"0,000", "5", "0,005"},
+		{"#,##0.000", "1234567.8915", "1,234,567.892"},
+		{"0,,", "123456789", "123"},
+		{"0.0,,", "123456789", "123.5"},
+		{`#,##0.0,,"M"`, "123456789", "123.5M"},
+		{`#,##0,"K"`, "1234567", "1,235K"},
+		{"000-00-0000", "123456789", "123-45-6789"},
+		{`"Tel: "0`, "42", "Tel: 42"},
+		{`0\ \k\g`, "5", "5 kg"},
+		{"#.##", "5", "5."},
+		{"0.0#", "1.5", "1.5"},
+		{"0.0#", "1.25", "1.25"},
+		{"0.##", "1.234", "1.23"},
+		{"???.??", "1.5", "  1.5 "},
+		{".00", "5.5", "5.50"},
+		{"0%", "0.256", "26%"},
+		{"0.0%", "0.256", "25.6%"},
+		{"0.00%", "-0.0512", "-5.12%"},
+		{"$#,##0.00", "1234.5", "$1,234.50"},
+		{"$#,##0.00", "-1234.5", "-$1,234.50"},
+		{"[$€-407] #,##0.00", "1234.5", "€ 1,234.50"},
+		{"#,##0.00;(#,##0.00)", "-1234.5", "(1,234.50)"},
+		{"#,##0.00;[Red]-#,##0.00", "-1234.5", "-1,234.50"},
+		{`0;-0;"zero"`, "0", "zero"},
+		{`_(* #,##0_);_(* \(#,##0\);_(* "-"_);_(@_)`, "1234", " 1,234 "},
+		{`_(* #,##0_);_(* \(#,##0\);_(* "-"_);_(@_)`, "-1234", " (1,234)"},
+		{`_(* #,##0_);_(* \(#,##0\);_(* "-"_);_(@_)`, "0", " - "},
+		{"0.00E+00", "12345", "1.23E+04"},
+		{"0.00E+00", "0.000123", "1.23E-04"},
+		{"0.00E+00", "-12345", "-1.23E+04"},
+		{"0.00E+00", "0", "0.00E+00"},
+		{"0.00E+00", "9.999", "1.00E+01"},
+		{"0.0E-0", "12345", "1.2E4"},
+		{"00.00E+00", "12345", "12.35E+03"},
+		{"##0.0E+0", "12345", "12.3E+3"},
+		{"##0.0E+0", "1234567", "1.2E+6"},
+		{"##0.0E+0", "0.0001234", "123.4E-6"},
+		{"0.00E+00", "1E+100", "1.00E+100"},
+		{"0.00", "NaN", "NaN"},
+		{"0.00", "Inf", "+Inf"},
+		{"#,##0", "-Infinity", "-Inf"},
+		{"0.00E+00", "Inf", "+Inf"},
+		{"yyyy-mm-dd", "NaN", "NaN"},
+		{"0.00%", "1e307", "1E+307"},
+		{"0.00%", "-1e307", "-1E+307"},
+		{"0.0E+0", "-5e-324", "-4.9E-324"},
+		{"0.00E+00", "2.5e-310", "2.50E-310"},
+	}
+	for _, tt := range tests {
+		format := parseFullNumberFormatString(tt.format)
+		require.NoError(t, format.parseEncounteredError, tt.format)
+		got, err := format.numeric(tt.value, false)
+		require.NoError(t, err, tt.format)
+		require.Equal(t, tt.want, got, "%s with %s", tt.format, tt.value)
+	}
+}
+
+func TestTextFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"general", "abc"},
+		{"@", "abc"},
+		{`"Name: "@`, "Name: abc"},
+		{`0.00;-0.00;0;"text"`, "text"},
+		{`0;-0;0;[Red]@" !"`, "abc !"},
+		{"0.00", "abc"},
+	}
+	for _, tt := range tests {
+		format := parseFullNumberFormatString(tt.format)
+		got, err := format.text("abc")
+		require.NoError(t, err, tt.format)
+		require.Equal(t, tt.want, got, tt.format)
+	}
+}
+
+func TestFractionFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		value  string
+		want   string
+	}{
+		{"# ?/?", "1.5", "1 1/2"},
+		{"# ?/?", "0.5", " 1/2"},
+		{"# ?/?", "0.333", " 1/3"},
+		{"# ?/?", "-1.25", "-1 1/4"},
+		{"# ?/?", "3", "3    "},
+		{"# ?/?", "0", "0    "},
+		{"# ?/?", "2.96", "3    "},
+		{"# ??/??", "3.14159", "3 14/99"},
+		{"# ??/??", "0.25", "  1/4 "},
+		{"# ???/???", "3.14159", "3  16/113"},
+		{"?/?", "1.25", "5/4"},
+		{"?/?", "0", "0/1"},
+		{"??/??", "0.75", " 3/4 "},
+		{"# ?/8", "1.5", "1 4/8"},
+		{"# ?/8", "0.3", " 2/8"},
+		{"# ?/8", "0.99", "1    "},
+		{"# ??/16", "2.4375", "2  7/16"},
+		{"?/4", "2.6", "10/4"},
+		{`# ?/4" in"`, "12.25", "12 1/4 in"},
+		{"#,##0 ?/?", "1234.5", "1,234 1/2"},
+		{"# ?/?;(# ?/?)", "-0.5", "( 1/2)"},
+		{"# ?/?", "NaN", "NaN"},
+		{"# ?/?", "Inf", "+Inf"},
+	}
+	for _, tt := range tests {
+		format := parseFullNumberFormatString(tt.format)
+		require.NoError(t, format.parseEncounteredError, tt.format)
+		got, err := format.numeric(tt.value, false)
+		require.NoError(t, err, tt.format)
+		require.Equal(t, tt.want, got, "%s with %s", tt.format, tt.value)
+	}
+
+	num, den := approximateFraction(math.NaN(), 9)
+	require.Equal(t, []int64{0, 1}, []int64{num, den})
+	num, den = approximateFraction(math.Inf(1), 9)
+	require.Equal(t, []int64{0, 1}, []int64{num, den})
+
+	require.Equal(t, NumberFormatFraction, ClassifyNumberFormat(builtinNumFormats[12]))
+	require.Equal(t, NumberFormatFraction, ClassifyNumberFormat("# ?/8"))
+}
@@ -0,0 +1,590 @@
+package xlsx
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type formatTokenKind int
+
+const (
+	tokenLiteral formatTokenKind = iota
+	tokenDigit
+	tokenPoint
+	tokenExponent
+	tokenText
+	tokenNumerator
+	tokenSlash
+	tokenDenominator
+)
+
+// formatToken is a piece of a number format section. Digit, numerator and
+// denominator tokens keep the placeholder, 0, # or ?, exponent tokens the E
+// or e and the sign.
+type formatToken struct {
+	kind formatTokenKind
+	text string
+}
+
+// numberSection is a parsed number format section. Commas between integer
+// placeholders turn on grouping, commas after the last placeholder divide
+// the value by 1000 each. Fraction sections have numerator and denominator
+// placeholders or a fixed denominator, integer placeholders before the
+// numerator make a mixed number.
+type numberSection struct {
+	tokens      []formatToken
+	intDigits   int
+	fracDigits  int
+	expDigits   int
+	grouping    bool
+	scale       int
+	percent     int
+	exponent    bool
+	hasText     bool
+	fraction    bool
+	numDigits   int
+	denDigits   int
+	denominator int
+}
+
+func isDigitPlaceholder(r rune) bool {
+	return r == '0' || r == '#' || r == '?'
+}
+
+// parseNumberSection parses a section that isn't a date or time format.
+func parseNumberSection(format string) (*numberSection, error) {
+	const (
+		partInt = iota
+		partFrac
+		partExp
+	)
+
+	s := &numberSection{}
+	literal := func(text string) {
+		if n := len(s.tokens); n > 0 && s.tokens[n-1].kind == tokenLiteral {
+			s.tokens[n-1].text += text
+			return
+		}
+		s.tokens = append(s.tokens, formatToken{kind: tokenLiteral, text: text})
+	}
+
+	part := partInt
+	digits := false
+	runes := []rune(format)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '"':
+			end, err := skipToRune(runes[i:], '"')
+			if err != nil {
+				return nil, ErrDoubleQuote
+			}
+			literal(string(runes[i+1 : i+end]))
+			i += end
+		case r == '\\':
+			if i+1 < len(runes) {
+				i++
+				literal(string(runes[i]))
+			}
+		case r == '_':
+			if i+1 < len(runes) {
+				i++
+				literal(" ")
+			}
+		case r == '*':
+			// The fill character repeats to the cell width, which is unknown.
+			i++
+		case r == '[':
+			end, err := skipToRune(runes[i:], ']')
+			if err != nil {
+				return nil, ErrInvalidBrackets
+			}
+			block := string(runes[i+1 : i+end])
+			switch {
+			case strings.HasPrefix(block, "$"):
+				symbol, _, _ := strings.Cut(block[1:], "-")
+				literal(symbol)
+			case strings.HasPrefix(block, "<"), strings.HasPrefix(block, ">"), strings.HasPrefix(block, "="):
+				return nil, fmt.Errorf("unsupported formatting code: %s", format)
+			}
+			i += end
+		case isDigitPlaceholder(r):
+			digits = true
+			s.tokens = append(s.tokens, formatToken{kind: tokenDigit, text: string(r)})
+			switch part {
+			case partInt:
+				s.intDigits++
+			case partFrac:
+				s.fracDigits++
+			case partExp:
+				s.expDigits++
+			}
+		case r == '/' && part == partInt && s.endsWithDigit() && i+1 < len(runes) &&
+			(isDigitPlaceholder(runes[i+1]) || (runes[i+1] >= '1' && runes[i+1] <= '9')):
+			s.fraction = true
+			for j := len(s.tokens) - 1; j >= 0 && s.tokens[j].kind == tokenDigit; j-- {
+				s.tokens[j].kind = tokenNumerator
+				s.intDigits--
+				s.numDigits++
+			}
+			s.tokens = append(s.tokens, formatToken{kind: tokenSlash})
+
+			j := i + 1
+			if isDigitPlaceholder(runes[j]) {
+				for ; j < len(runes) && isDigitPlaceholder(runes[j]); j++ {
+					s.tokens = append(s.tokens, formatToken{kind: tokenDenominator, text: string(runes[j])})
+					s.denDigits++
+				}
+			} else {
+				for ; j < len(runes) && runes[j] >= '0' && runes[j] <= '9'; j++ {
+					s.denominator = s.denominator*10 + int(runes[j]-'0')
+				}
+				s.tokens = append(s.tokens, formatToken{kind: tokenDenominator, text: strconv.Itoa(s.denominator)})
+			}
+			i = j - 1
+			part = partFrac
+		case r == '.' && part == partInt:
+			part = partFrac
+			s.tokens = append(s.tokens, formatToken{kind: tokenPoint})
+		case r == ',' && digits:
+			if part == partInt && i+1 < len(runes) && isDigitPlaceholder(runes[i+1]) {
+				s.grouping = true
+			} else if part != partExp {
+				s.scale++
+			}
+		case (r == 'E' || r == 'e') && digits && part != partExp && i+1 < len(runes) && (runes[i+1] == '+' || runes[i+1] == '-'):
+			part = partExp
+			s.exponent = true
+			s.tokens = append(s.tokens, formatToken{kind: tokenExponent, text: string(runes[i : i+2])})
+			i++
+		case r == '%':
+			s.percent++
+			literal("%")
+		case r == '@':
+			s.hasText = true
+			s.tokens = append(s.tokens, formatToken{kind: tokenText})
+		default:
+			literal(string(r))
+		}
+	}
+	return s, nil
+}
+
+func (s *numberSection) endsWithDigit() bool {
+	return len(s.tokens) > 0 && s.tokens[len(s.tokens)-1].kind == tokenDigit
+}
+
+// roundDecimal rounds v half away from zero to frac decimals and returns the
+// integer digits without leading zeros and exactly frac fraction digits. The
+// value is first reduced to 15 significant digits, the precision Excel shows,
+// so 2.675 rounds to 2.68.
+func roundDecimal(v float64, frac int) (string, string) {
+	mantissa, exp, _ := strings.Cut(strconv.FormatFloat(math.Abs(v), 'e', 14, 64), "e")
+	digits := []byte(strings.Replace(mantissa, ".", "", 1))
+	point, _ := strconv.Atoi(exp)
+	point++
+
+	if point < 0 {
+		digits = append([]byte(strings.Repeat("0", -point)), digits...)
+		point = 0
+	}
+
+	keep := point + frac
+	if keep < len(digits) {
+		up := digits[keep] >= '5'
+		digits = digits[:keep]
+		if up {
+			i := len(digits) - 1
+			for ; i >= 0 && digits[i] == '9'; i-- {
+				digits[i] = '0'
+			}
+			if i >= 0 {
+				digits[i]++
+			} else {
+				digits = append([]byte{'1'}, digits...)
+				point++
+			}
+		}
+	}
+	for len(digits) < point+frac {
+		digits = append(digits, '0')
+	}
+
+	intPart := strings.TrimLeft(string(digits[:point]), "0")
+	return intPart, string(digits[point:])
+}
+
+// format renders a number, the sign is shown only when negative is set and
+// the rounded value isn't zero.
+func (s *numberSection) format(v float64, negative bool) string {
+	value := v
+	v = math.Abs(v)
+	for i := 0; i < s.percent; i++ {
+		v *= 100
+	}
+	for i := 0; i < s.scale; i++ {
+		v /= 1000
+	}
+	// percents can push a huge value out of range
+	if math.IsInf(v, 0) {
+		general, _ := generalNumericScientific(strconv.FormatFloat(value, 'g', -1, 64), true)
+		return general
+	}
+
+	if s.fraction {
+		return s.formatFraction(v, negative)
+	}
+
+	var intPart, fracPart string
+	exp := 0
+	if s.exponent {
+		intPart, fracPart, exp = s.scientific(v)
+	} else {
+		intPart, fracPart = roundDecimal(v, s.fracDigits)
+	}
+
+	var b strings.Builder
+	if negative && strings.Trim(intPart+fracPart, "0") != "" {
+		b.WriteByte('-')
+	}
+
+	intIdx, fracIdx, expIdx := 0, 0, 0
+	expText := strconv.Itoa(abs(exp))
+	for _, t := range s.tokens {
+		switch t.kind {
+		case tokenLiteral:
+			b.WriteString(t.text)
+		case tokenText:
+			b.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+		case tokenPoint:
+			if s.intDigits == 0 {
+				s.writeGrouped(&b, intPart, len(intPart)-1, 0)
+			}
+			b.WriteByte('.')
+		case tokenExponent:
+			b.WriteByte(t.text[0])
+			if exp < 0 {
+				b.WriteByte('-')
+			} else if t.text[1] == '+' {
+				b.WriteByte('+')
+			}
+		case tokenDigit:
+			switch {
+			case intIdx < s.intDigits:
+				pos := s.intDigits - 1 - intIdx
+				if intIdx == 0 && len(intPart) > s.intDigits {
+					s.writeGrouped(&b, intPart, len(intPart)-1, s.intDigits)
+				}
+				s.writeIntDigit(&b, t.text[0], intPart, pos)
+				intIdx++
+			case fracIdx < s.fracDigits:
+				writeFracDigit(&b, t.text[0], fracPart, fracIdx, s.fracTrim(fracPart))
+				fracIdx++
+			default:
+				pos := s.expDigits - 1 - expIdx
+				if expIdx == 0 && len(expText) > s.expDigits {
+					b.WriteString(expText[:len(expText)-s.expDigits])
+				}
+				switch {
+				case pos < len(expText):
+					b.WriteByte(expText[len(expText)-1-pos])
+				case t.text[0] == '0':
+					b.WriteByte('0')
+				case t.text[0] == '?':
+					b.WriteByte(' ')
+				}
+				expIdx++
+			}
+		}
+	}
+	return b.String()
+}
+
+// writeGrouped writes the integer digits from position from down to position
+// to, counted from the right.
+func (s *numberSection) writeGrouped(b *strings.Builder, intPart string, from, to int) {
+	for pos := from; pos >= to; pos-- {
+		b.WriteByte(intPart[len(intPart)-1-pos])
+		if s.grouping && pos > 0 && pos%3 == 0 {
+			b.WriteByte(',')
+		}
+	}
+}
+
+// writeIntDigit writes the integer placeholder at position pos from the
+// right. Missing digits are zeros for 0, spaces for ? and nothing for #.
+func (s *numberSection) writeIntDigit(b *strings.Builder, placeholder byte, intPart string, pos int) {
+	switch {
+	case pos < len(intPart):
+		b.WriteByte(intPart[len(intPart)-1-pos])
+	case placeholder == '0':
+		b.WriteByte('0')
+	case placeholder == '?':
+		b.WriteByte(' ')
+		return
+	default:
+		return
+	}
+	if s.grouping && pos > 0 && pos%3 == 0 {
+		b.WriteByte(',')
+	}
+}
+
+// fracTrim returns the number of trailing fraction digits that are zeros
+// under # or ? placeholders.
+func (s *numberSection) fracTrim(fracPart string) int {
+	placeholders := make([]byte, 0, s.fracDigits)
+	seen := 0
+	for _, t := range s.tokens {
+		if t.kind != tokenDigit {
+			continue
+		}
+		if seen >= s.intDigits && len(placeholders) < s.fracDigits {
+			placeholders = append(placeholders, t.text[0])
+		}
+		seen++
+	}
+
+	n := 0
+	for i := len(fracPart) - 1; i >= 0; i-- {
+		if fracPart[i] != '0' || placeholders[i] == '0' {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+func writeFracDigit(b *strings.Builder, placeholder byte, fracPart string, idx, trim int) {
+	if idx < len(fracPart)-trim {
+		b.WriteByte(fracPart[idx])
+		return
+	}
+	if placeholder == '?' {
+		b.WriteByte(' ')
+	}
+}
+
+// scientific splits v into mantissa digits and exponent. The mantissa has as
+// many integer digits as the format has integer placeholders, formats like
+// ##0.0E+0 that start with # keep the exponent a multiple of the placeholder
+// count instead.
+func (s *numberSection) scientific(v float64) (string, string, int) {
+	if v == 0 {
+		intPart, fracPart := roundDecimal(0, s.fracDigits)
+		return intPart, fracPart, 0
+	}
+
+	width := max(s.intDigits, 1)
+	engineering := width > 1 && s.firstIntPlaceholder() == '#'
+
+	var (
+		intPart, fracPart string
+		e                 int
+	)
+	// math.Log10 is off for subnormal values, the decimal exponent is exact
+	_, expText, _ := strings.Cut(strconv.FormatFloat(v, 'e', -1, 64), "e")
+	exp, _ := strconv.Atoi(expText)
+	for try := 0; try < 3; try++ {
+		e = exp - (width - 1)
+		if engineering {
+			e = int(math.Floor(float64(exp)/float64(width))) * width
+		}
+
+		intPart, fracPart = roundDecimal(scaleExp(v, e), s.fracDigits)
+		switch {
+		case len(intPart) > width:
+			exp++
+		case !engineering && len(intPart) < width:
+			exp--
+		default:
+			return intPart, fracPart, e
+		}
+	}
+	return intPart, fracPart, e
+}
+
+// scaleExp returns v / 10^e. Subnormal values are scaled in two steps, as
+// 10^e itself underflows for them.
+func scaleExp(v float64, e int) float64 {
+	if e < -300 {
+		return v * 1e300 / math.Pow10(e+300)
+	}
+	return v / math.Pow10(e)
+}
+
+func (s *numberSection) firstIntPlaceholder() byte {
+	for _, t := range s.tokens {
+		if t.kind == tokenDigit {
+			return t.text[0]
+		}
+	}
+	return 0
+}
+
+// formatText renders a text value with the text section, @ is the value.
+func (s *numberSection) formatText(value string) string {
+	var b strings.Builder
+	for _, t := range s.tokens {
+		switch t.kind {
+		case tokenLiteral:
+			b.WriteString(t.text)
+		case tokenText:
+			b.WriteString(value)
+		}
+	}
+	return b.String()
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// formatFraction renders a fraction section. A mixed number whose fraction
+// rounds to zero shows the integer part with the fraction blanked out.
+func (s *numberSection) formatFraction(v float64, negative bool) string {
+	mixed := s.intDigits > 0
+
+	whole := 0.0
+	if mixed {
+		whole = math.Floor(v)
+	}
+
+	var num, den int64
+	if s.denominator > 0 {
+		den = int64(s.denominator)
+		num = int64(math.Floor((v-whole)*float64(den) + 0.5))
+	} else {
+		num, den = approximateFraction(v-whole, int64(math.Pow10(s.denDigits))-1)
+	}
+	if mixed && num == den {
+		whole++
+		num = 0
+	}
+
+	intPart, _ := roundDecimal(whole, 0)
+	blank := mixed && num == 0
+	if blank && intPart == "" {
+		intPart = "0"
+	}
+
+	var b strings.Builder
+	if negative && (intPart != "" || num != 0) {
+		b.WriteByte('-')
+	}
+
+	numText := strconv.FormatInt(num, 10)
+	denText := strconv.FormatInt(den, 10)
+	intIdx, numIdx, denIdx := 0, 0, 0
+	for _, t := range s.tokens {
+		switch t.kind {
+		case tokenLiteral:
+			b.WriteString(t.text)
+		case tokenText:
+			b.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+		case tokenDigit:
+			pos := s.intDigits - 1 - intIdx
+			if intIdx == 0 && len(intPart) > s.intDigits {
+				s.writeGrouped(&b, intPart, len(intPart)-1, s.intDigits)
+			}
+			s.writeIntDigit(&b, t.text[0], intPart, pos)
+			intIdx++
+		case tokenNumerator:
+			pos := s.numDigits - 1 - numIdx
+			switch {
+			case blank:
+				b.WriteByte(' ')
+			case numIdx == 0 && len(numText) > s.numDigits:
+				b.WriteString(numText[:len(numText)-s.numDigits+1])
+			case pos < len(numText):
+				b.WriteByte(numText[len(numText)-1-pos])
+			case t.text[0] == '0':
+				b.WriteByte('0')
+			case t.text[0] == '?':
+				b.WriteByte(' ')
+			}
+			numIdx++
+		case tokenSlash:
+			if blank {
+				b.WriteByte(' ')
+			} else {
+				b.WriteByte('/')
+			}
+		case tokenDenominator:
+			switch {
+			case s.denominator > 0:
+				if blank {
+					b.WriteString(strings.Repeat(" ", len(t.text)))
+				} else {
+					b.WriteString(t.text)
+				}
+			case blank:
+				b.WriteByte(' ')
+			case denIdx == s.denDigits-1 && len(denText) > s.denDigits:
+				b.WriteString(denText[denIdx:])
+			case denIdx < len(denText):
+				b.WriteByte(denText[denIdx])
+			case t.text[0] == '0':
+				b.WriteByte('0')
+			case t.text[0] == '?':
+				b.WriteByte(' ')
+			}
+			denIdx++
+		}
+	}
+	return b.String()
+}
+
+// maxFractionSteps bounds the continued fraction expansion, float64 values
+// need far fewer terms.
+const maxFractionSteps = 64
+
+// approximateFraction returns the fraction closest to v with a denominator
+// up to maxDen, using the continued fraction expansion of v. Non-finite
+// values give 0/1.
+func approximateFraction(v float64, maxDen int64) (int64, int64) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, 1
+	}
+	if maxDen < 1 {
+		maxDen = 1
+	}
+
+	h0, h1 := int64(0), int64(1)
+	k0, k1 := int64(1), int64(0)
+	x := v
+	for step := 0; step < maxFractionSteps; step++ {
+		a := math.Floor(x)
+		if a > math.MaxInt32 {
+			break
+		}
+		h2 := int64(a)*h1 + h0
+		k2 := int64(a)*k1 + k0
+		if k2 > maxDen {
+			// The best semiconvergent may be closer than the last convergent.
+			t := (maxDen - k0) / k1
+			hs, ks := t*h1+h0, t*k1+k0
+			if ks > 0 && math.Abs(v-float64(hs)/float64(ks)) < math.Abs(v-float64(h1)/float64(k1)) {
+				return hs, ks
+			}
+			break
+		}
+		h0, h1 = h1, h2
+		k0, k1 = k1, k2
+
+		frac := x - a
+		if frac < 1e-12 || math.Abs(v-float64(h1)/float64(k1)) < 1e-12 {
+			break
+		}
+		x = 1 / frac
+	}
+	if k1 == 0 {
+		return int64(math.Round(v)), 1
+	}
+	return h1, k1
+}
@@ -0,0 +1,58 @@
+package xlsx
+
+// SheetOption configures how a sheet is read.
+type SheetOption func(*sheetOptions)
+
+type sheetOptions struct {
+	mergedCells      bool
+	mergedCellValues bool
+	visibleOnly      bool
+	richText         bool
+	bounds           *Range
+}
+
+// WithMergedCells reports the merged range of each visited cell through
+// Sheet.CellMergedRange. The merged ranges are read in a pre-pass over the sheet.
+func WithMergedCells() SheetOption {
+	return func(o *sheetOptions) {
+		o.mergedCells = true
+	}
+}
+
+// WithMergedCellValues is WithMergedCells that also gives every cell covered by
+// a merged range the value and format of its top-left cell. Covered cells
+// missing from the sheet are produced by NextCell as long as their row exists.
+func WithMergedCellValues() SheetOption {
+	return func(o *sheetOptions) {
+		o.mergedCells = true
+		o.mergedCellValues = true
+	}
+}
+
+// WithVisibleOnly skips hidden rows, cells of hidden columns and rows excluded
+// by the autoFilter of the sheet. Rows hidden by a filter are skipped by their
+// hidden flag, filter values and custom filters are also checked for files
+// saved without applying the filter. The autoFilter is read in a pre-pass over
+// the sheet.
+func WithVisibleOnly() SheetOption {
+	return func(o *sheetOptions) {
+		o.visibleOnly = true
+	}
+}
+
+// WithRange restricts the sheet to the rows and columns of the range. Rows
+// above the range and cells outside its columns are skipped, reading stops
+// with io.EOF after the last row of the range.
+func WithRange(r Range) SheetOption {
+	return func(o *sheetOptions) {
+		o.bounds = &r
+	}
+}
+
+// WithRichText keeps the runs and their fonts of inline string cells for
+// Sheet.CellRichText. Runs of shared strings are available without it.
+func WithRichText() SheetOption {
+	return func(o *sheetOptions) {
+		o.richText = true
+	}
+}
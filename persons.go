@@ -0,0 +1,81 @@
+package xlsx
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// Person is an author of threaded comments.
+type Person struct {
+	ID          string
+	DisplayName string
+	UserID      string
+	ProviderID  string
+}
+
+type personList struct {
+	XMLName xml.Name `xml:"personList"`
+	Person  []struct {
+		ID          string `xml:"id,attr"`
+		DisplayName string `xml:"displayName,attr"`
+		UserID      string `xml:"userId,attr"`
+		ProviderID  string `xml:"providerId,attr"`
+	} `xml:"person"`
+}
+
+func readPersons(reader io.Reader) ([]Person, error) {
+	decoder := xml.NewDecoder(reader)
+	data := &personList{}
+	err := decoder.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Person, 0, len(data.Person))
+	for _, p := range data.Person {
+		result = append(result, Person{
+			ID:          p.ID,
+			DisplayName: p.DisplayName,
+			UserID:      p.UserID,
+			ProviderID:  p.ProviderID,
+		})
+	}
+	return result, nil
+}
+
+func (x *Xlsx) loadPersons() ([]Person, error) {
+	x.personsOnce.Do(func() {
+		for _, part := range x.workbookParts(relTypePerson) {
+			zipFile, ok := x.files[part]
+			if !ok {
+				continue
+			}
+
+			reader, err := zipFile.Open()
+			if err != nil {
+				x.personsErr = err
+				return
+			}
+			persons, err := readPersons(reader)
+			_ = reader.Close()
+			if err != nil {
+				x.personsErr = err
+				return
+			}
+			x.persons = append(x.persons, persons...)
+		}
+	})
+	return x.persons, x.personsErr
+}
+
+// Persons returns the authors of threaded comments of the workbook.
+func (x *Xlsx) Persons() ([]Person, error) {
+	persons, err := x.loadPersons()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Person, len(persons))
+	copy(result, persons)
+	return result, nil
+}
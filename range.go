@@ -0,0 +1,87 @@
+package xlsx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Range is a rectangular block of cells with zero-based bounds, inclusive.
+type Range struct {
+	FirstRow int
+	FirstCol int
+	LastRow  int
+	LastCol  int
+}
+
+// ParseRange parses A1 style references like B2, A1:C3, $A$1:$B$2, A:C or 2:5.
+func ParseRange(ref string) (Range, error) {
+	ref = strings.ReplaceAll(ref, "$", "")
+	first, last, isRange := strings.Cut(ref, ":")
+	if !isRange {
+		last = first
+	}
+
+	col1, row1, ok1 := parseCellRef([]byte(first))
+	col2, row2, ok2 := parseCellRef([]byte(last))
+	if ok1 && ok2 {
+		return newRange(row1, col1, row2, col2), nil
+	}
+
+	if isRange {
+		col1, ok1 = parseColumnRef(first)
+		col2, ok2 = parseColumnRef(last)
+		if ok1 && ok2 {
+			return newRange(0, col1, maxRows-1, col2), nil
+		}
+
+		row1, ok1 = parseRowRef(first)
+		row2, ok2 = parseRowRef(last)
+		if ok1 && ok2 {
+			return newRange(row1, 0, row2, maxColumns-1), nil
+		}
+	}
+
+	return Range{}, fmt.Errorf("can not parse range %q: %w", ref, ErrInvalidRange)
+}
+
+func newRange(row1, col1, row2, col2 int) Range {
+	return Range{
+		FirstRow: min(row1, row2),
+		FirstCol: min(col1, col2),
+		LastRow:  max(row1, row2),
+		LastCol:  max(col1, col2),
+	}
+}
+
+func parseColumnRef(ref string) (int, bool) {
+	if ref == "" || len(ref) > 3 {
+		return 0, false
+	}
+	for i := 0; i < len(ref); i++ {
+		if ref[i] < 'A' || ref[i] > 'Z' {
+			return 0, false
+		}
+	}
+	col := columnIndex([]byte(ref))
+	return col, col < maxColumns
+}
+
+func parseRowRef(ref string) (int, bool) {
+	row, err := strconv.Atoi(ref)
+	if err != nil || row < 1 || row > maxRows || ref[0] == '+' {
+		return 0, false
+	}
+	return row - 1, true
+}
+
+func (r Range) Contains(row, col int) bool {
+	return row >= r.FirstRow && row <= r.LastRow && col >= r.FirstCol && col <= r.LastCol
+}
+
+func (r Range) String() string {
+	if r.FirstRow == r.LastRow && r.FirstCol == r.LastCol {
+		return cellName(r.FirstCol, r.FirstRow)
+	}
+	return cellName(r.FirstCol, r.FirstRow) + ":" + cellName(r.LastCol, r.LastRow)
+}
@@ -0,0 +1,35 @@
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		ref      string
+		expected Range
+	}{
+		{"B2", Range{FirstRow: 1, FirstCol: 1, LastRow: 1, LastCol: 1}},
+		{"A1:C3", Range{FirstRow: 0, FirstCol: 0, LastRow: 2, LastCol: 2}},
+		{"$C$3:$A$1", Range{FirstRow: 0, FirstCol: 0, LastRow: 2, LastCol: 2}},
+		{"B:C", Range{FirstRow: 0, FirstCol: 1, LastRow: maxRows - 1, LastCol: 2}},
+		{"$2:$5", Range{FirstRow: 1, FirstCol: 0, LastRow: 4, LastCol: maxColumns - 1}},
+	}
+	for _, test := range tests {
+		r, err := ParseRange(test.ref)
+		require.NoError(t, err, test.ref)
+		require.Equal(t, test.expected, r, test.ref)
+	}
+
+	for _, ref := range []string{"", "A", "1", "A0", "A1:", "Prices", "XFE1"} {
+		_, err := ParseRange(ref)
+		require.ErrorIs(t, err, ErrInvalidRange, ref)
+	}
+
+	require.Equal(t, "B2", Range{FirstRow: 1, FirstCol: 1, LastRow: 1, LastCol: 1}.String())
+	require.Equal(t, "A1:C3", Range{LastRow: 2, LastCol: 2}.String())
+	require.True(t, Range{LastRow: 2, LastCol: 2}.Contains(2, 1))
+	require.False(t, Range{LastRow: 2, LastCol: 2}.Contains(3, 1))
+}
@@ -0,0 +1,81 @@
+package xlsx
+
+import (
+	"cmp"
+	"encoding/xml"
+	"io"
+	"path"
+	"slices"
+	"strings"
+)
+
+const (
+	relTypeWorksheet       = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet"
+	relTypeChartsheet      = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/chartsheet"
+	relTypeDialogsheet     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/dialogsheet"
+	relTypeMacrosheet      = "http://schemas.microsoft.com/office/2006/relationships/xlMacrosheet"
+	relTypeIntlMacrosheet  = "http://schemas.microsoft.com/office/2006/relationships/xlIntlMacrosheet"
+	relTypeHyperlink       = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink"
+	relTypeComments        = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/comments"
+	relTypeTable           = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/table"
+	relTypeTheme           = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/theme"
+	relTypeThreadedComment = "http://schemas.microsoft.com/office/2017/10/relationships/threadedComment"
+	relTypePerson          = "http://schemas.microsoft.com/office/2017/10/relationships/person"
+)
+
+func readRelationships(reader io.Reader) (*relationships, error) {
+	decoder := xml.NewDecoder(reader)
+	data := &relationships{}
+	err := decoder.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+type relationships struct {
+	XMLName      xml.Name       `xml:"Relationships"`
+	Relationship []relationship `xml:"Relationship"`
+}
+
+type relationship struct {
+	ID         string `xml:"Id,attr"`
+	Type       string `xml:"Type,attr"`
+	Target     string `xml:"Target,attr"`
+	TargetMode string `xml:"TargetMode,attr"`
+}
+
+func (r relationship) isExternal() bool {
+	return r.TargetMode == "External"
+}
+
+// sortedRels returns the relationships ordered by id, so the parts they
+// point to are read in the same order every time. rId2 goes before rId10.
+func sortedRels(rels map[string]relationship) []relationship {
+	result := make([]relationship, 0, len(rels))
+	for _, rel := range rels {
+		result = append(result, rel)
+	}
+	slices.SortFunc(result, func(a, b relationship) int {
+		if c := cmp.Compare(len(a.ID), len(b.ID)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return result
+}
+
+// relsPath returns the relationships part of a package part,
+// xl/worksheets/sheet1.xml has xl/worksheets/_rels/sheet1.xml.rels.
+func relsPath(part string) string {
+	dir, file := path.Split(part)
+	return dir + "_rels/" + file + ".rels"
+}
+
+// resolveTarget resolves a relationship target relative to the source part.
+func resolveTarget(part, target string) string {
+	if strings.HasPrefix(target, "/") {
+		return target[1:]
+	}
+	return path.Join(path.Dir(part), target)
+}
@@ -0,0 +1,153 @@
+package xlsx
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/anfilat/xlsx-sax/internal/xml"
+)
+
+// readRichStrings returns the runs of the shared strings that have them, by
+// shared string index. Plain strings are left to readSharedStrings.
+func readRichStrings(reader io.Reader) (map[int][]RichTextRun, error) {
+	decoder := xml.NewDecoder(reader, fontTagAttrs())
+
+	var (
+		runs []RichTextRun
+		isT  bool
+		isR  bool
+	)
+	result := make(map[int][]RichTextRun)
+	idx := -1
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				return result, nil
+			}
+			return nil, err
+		}
+
+		switch token := t.(type) {
+		case *xml.StartElement:
+			switch token.Name.Local {
+			case "si":
+				idx++
+				runs = nil
+			case "r":
+				isR = true
+				runs = append(runs, RichTextRun{})
+			case "rPr":
+				font, er := readFont(decoder)
+				if er != nil {
+					return nil, er
+				}
+				if isR {
+					runs[len(runs)-1].Font = font
+				}
+			case "t":
+				isT = true
+			case "sst":
+				//
+			default:
+				if er := decoder.Skip(); er != nil {
+					return nil, er
+				}
+			}
+		case *xml.EndElement:
+			switch token.Name.Local {
+			case "si":
+				if len(runs) > 0 {
+					result[idx] = runs
+				}
+			case "r":
+				isR = false
+			case "t":
+				isT = false
+			}
+		case *xml.CharData:
+			if isT && isR {
+				runs[len(runs)-1].Text += string(token.Value)
+			}
+		}
+	}
+}
+
+func (x *Xlsx) loadRichStrings() (map[int][]RichTextRun, error) {
+	x.richStringsOnce.Do(func() {
+		if x.sharedFile == nil {
+			return
+		}
+
+		reader, err := x.sharedFile.Open()
+		if err != nil {
+			x.richStringsErr = err
+			return
+		}
+		defer reader.Close()
+
+		x.richStrings, x.richStringsErr = readRichStrings(reader)
+		for _, runs := range x.richStrings {
+			for _, run := range runs {
+				if run.Font != nil {
+					x.colors.resolve(&run.Font.Color)
+				}
+			}
+		}
+	})
+	return x.richStrings, x.richStringsErr
+}
+
+// CellRichText returns the text runs of the current string cell. A string
+// without formatting is a single run without font, an empty string and
+// other cell types have no runs. Runs of inline strings are kept only when
+// the sheet is opened WithRichText. The shared string runs are read on the
+// first call.
+func (s *Sheet) CellRichText() ([]RichTextRun, error) {
+	var text string
+	switch s.cellType {
+	case CellTypeString:
+		idx, err := strconv.Atoi(string(s.cellValue))
+		if err != nil {
+			return nil, err
+		}
+		text, err = s.sharedStrings.get(idx)
+		if err != nil {
+			return nil, err
+		}
+
+		rich, err := s.x.loadRichStrings()
+		if err != nil {
+			return nil, err
+		}
+		if runs, ok := rich[idx]; ok {
+			return copyRuns(runs), nil
+		}
+	case CellTypeInline:
+		if len(s.cellRuns) > 0 {
+			return copyRuns(s.cellRuns), nil
+		}
+		text = string(s.cellValue)
+	case CellTypeFormula:
+		text = string(s.cellValue)
+	default:
+		return nil, nil
+	}
+
+	if text == "" {
+		return nil, nil
+	}
+	return []RichTextRun{{Text: text}}, nil
+}
+
+func copyRuns(runs []RichTextRun) []RichTextRun {
+	result := make([]RichTextRun, len(runs))
+	for i, run := range runs {
+		if run.Font != nil {
+			f := *run.Font
+			run.Font = &f
+		}
+		result[i] = run
+	}
+	return result
+}
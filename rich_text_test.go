@@ -0,0 +1,83 @@
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCellRichText(t *testing.T) {
+	parts := func() map[string]string {
+		return map[string]string{
+			"xl/sharedStrings.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" count="2" uniqueCount="2">` +
+				`<si><t>plain</t></si>` +
+				`<si><r><t xml:space="preserve">old </t></r><r><rPr><strike/><sz val="11"/><color rgb="FFFF0000"/><rFont val="Calibri"/></rPr><t>deleted</t></r><rPh sb="0" eb="1"><t>x</t></rPh></si>` +
+				`</sst>`,
+			"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData><row r="1">` +
+				`<c r="A1" t="s"><v>0</v></c>` +
+				`<c r="B1" t="s"><v>1</v></c>` +
+				`<c r="C1" t="inlineStr"><is><r><rPr><b/></rPr><t>bold</t></r><r><t xml:space="preserve"> text</t></r><rPh sb="0" eb="1"><t>ph</t></rPh></is></c>` +
+				`<c r="D1" t="inlineStr"><is><t>inline</t></is></c>` +
+				`<c r="E1"><v>1</v></c>` +
+				`</row></sheetData>`),
+		}
+	}
+
+	xlsx := newTestXlsx(t, parts())
+	sheet, err := xlsx.OpenSheetByOrder(0, WithRichText())
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	require.True(t, sheet.NextRow())
+
+	require.True(t, sheet.NextCell())
+	runs, err := sheet.CellRichText()
+	require.NoError(t, err)
+	require.Equal(t, []RichTextRun{{Text: "plain"}}, runs)
+
+	require.True(t, sheet.NextCell())
+	runs, err = sheet.CellRichText()
+	require.NoError(t, err)
+	require.Equal(t, []RichTextRun{
+		{Text: "old "},
+		{Text: "deleted", Font: &Font{Name: "Calibri", Size: 11, Strike: true, Color: Color{Kind: ColorRGB, RGB: "FFFF0000", ARGB: "FFFF0000"}}},
+	}, runs)
+	v, err := sheet.CellValue()
+	require.NoError(t, err)
+	require.Equal(t, "old deleted", v)
+
+	require.True(t, sheet.NextCell())
+	runs, err = sheet.CellRichText()
+	require.NoError(t, err)
+	require.Equal(t, []RichTextRun{
+		{Text: "bold", Font: &Font{Bold: true}},
+		{Text: " text"},
+	}, runs)
+	v, err = sheet.CellValue()
+	require.NoError(t, err)
+	require.Equal(t, "bold text", v)
+
+	require.True(t, sheet.NextCell())
+	runs, err = sheet.CellRichText()
+	require.NoError(t, err)
+	require.Equal(t, []RichTextRun{{Text: "inline"}}, runs)
+
+	require.True(t, sheet.NextCell())
+	runs, err = sheet.CellRichText()
+	require.NoError(t, err)
+	require.Nil(t, runs)
+
+	xlsx = newTestXlsx(t, parts())
+	plain, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer plain.Close()
+
+	require.True(t, plain.NextRow())
+	require.True(t, plain.NextCell())
+	require.True(t, plain.NextCell())
+	require.True(t, plain.NextCell())
+	runs, err = plain.CellRichText()
+	require.NoError(t, err)
+	require.Equal(t, []RichTextRun{{Text: "bold text"}}, runs)
+}
@@ -0,0 +1,117 @@
+package xlsx
+
+import (
+	"strconv"
+
+	"github.com/anfilat/xlsx-sax/internal/xml"
+)
+
+// RowInfo holds the attributes of a row. Height is in points and is zero
+// for rows with the default height.
+type RowInfo struct {
+	Height       float64
+	CustomHeight bool
+	Hidden       bool
+	OutlineLevel int
+	Collapsed    bool
+}
+
+// Column describes a block of columns with the same settings. FirstCol and
+// LastCol are zero-based, Width is in characters of the default font and is
+// zero when not set.
+type Column struct {
+	FirstCol     int
+	LastCol      int
+	Width        float64
+	CustomWidth  bool
+	BestFit      bool
+	Hidden       bool
+	OutlineLevel int
+	Collapsed    bool
+	Style        int
+}
+
+func parseRowInfo(attrs []xml.Attr) (RowInfo, error) {
+	var (
+		info RowInfo
+		err  error
+	)
+	for _, a := range attrs {
+		switch a.Name.Local {
+		case "ht":
+			info.Height, err = strconv.ParseFloat(a.Value.String(), 64)
+		case "customHeight":
+			info.CustomHeight = parseBoolAttr(a.Value.Bytes())
+		case "hidden":
+			info.Hidden = parseBoolAttr(a.Value.Bytes())
+		case "outlineLevel":
+			info.OutlineLevel, err = strconv.Atoi(a.Value.String())
+		case "collapsed":
+			info.Collapsed = parseBoolAttr(a.Value.Bytes())
+		}
+		if err != nil {
+			return RowInfo{}, ErrIncorrectSheet
+		}
+	}
+	return info, nil
+}
+
+func parseColumn(attrs []xml.Attr) (Column, error) {
+	var (
+		c   Column
+		err error
+	)
+	for _, a := range attrs {
+		switch a.Name.Local {
+		case "min":
+			c.FirstCol, err = strconv.Atoi(a.Value.String())
+			c.FirstCol--
+		case "max":
+			c.LastCol, err = strconv.Atoi(a.Value.String())
+			c.LastCol--
+		case "width":
+			c.Width, err = strconv.ParseFloat(a.Value.String(), 64)
+		case "customWidth":
+			c.CustomWidth = parseBoolAttr(a.Value.Bytes())
+		case "bestFit":
+			c.BestFit = parseBoolAttr(a.Value.Bytes())
+		case "hidden":
+			c.Hidden = parseBoolAttr(a.Value.Bytes())
+		case "outlineLevel":
+			c.OutlineLevel, err = strconv.Atoi(a.Value.String())
+		case "collapsed":
+			c.Collapsed = parseBoolAttr(a.Value.Bytes())
+		case "style":
+			c.Style, err = strconv.Atoi(a.Value.String())
+		}
+		if err != nil {
+			return Column{}, ErrIncorrectSheet
+		}
+	}
+	if c.FirstCol < 0 || c.LastCol < c.FirstCol || c.LastCol >= maxColumns {
+		return Column{}, ErrIncorrectSheet
+	}
+	return c, nil
+}
+
+// RowInfo returns the attributes of the current row.
+func (s *Sheet) RowInfo() RowInfo {
+	return s.rowInfo
+}
+
+// Columns returns the column settings of the sheet ordered as in the file.
+func (s *Sheet) Columns() []Column {
+	result := make([]Column, len(s.columns))
+	copy(result, s.columns)
+	return result
+}
+
+// ColumnInfo returns the settings of the given zero-based column.
+func (s *Sheet) ColumnInfo(col int) (Column, bool) {
+	for _, c := range s.columns {
+		if col >= c.FirstCol && col <= c.LastCol {
+			return c, true
+		}
+	}
+	return Column{}, false
+}
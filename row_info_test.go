@@ -0,0 +1,48 @@
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowInfoAndColumns(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetFormatPr defaultRowHeight="15"/>` +
+			`<cols><col min="1" max="1" width="20.5" customWidth="1"/><col min="2" max="4" width="9" hidden="1" outlineLevel="1" collapsed="1" style="3"/></cols>` +
+			`<sheetData>` +
+			`<row r="1" ht="30" customHeight="1"><c r="A1"><v>1</v></c></row>` +
+			`<row r="2" hidden="1" outlineLevel="2"><c r="A2"><v>2</v></c></row>` +
+			`<row r="3" collapsed="1"><c r="A3"><v>3</v></c></row>` +
+			`</sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	require.Equal(t, []Column{
+		{FirstCol: 0, LastCol: 0, Width: 20.5, CustomWidth: true},
+		{FirstCol: 1, LastCol: 3, Width: 9, Hidden: true, OutlineLevel: 1, Collapsed: true, Style: 3},
+	}, sheet.Columns())
+
+	c, ok := sheet.ColumnInfo(2)
+	require.True(t, ok)
+	require.True(t, c.Hidden)
+	_, ok = sheet.ColumnInfo(4)
+	require.False(t, ok)
+
+	require.True(t, sheet.NextRow())
+	require.Equal(t, RowInfo{Height: 30, CustomHeight: true}, sheet.RowInfo())
+
+	// the next row is found while reading cells of the current one
+	require.True(t, sheet.NextCell())
+	require.False(t, sheet.NextCell())
+	require.Equal(t, RowInfo{Height: 30, CustomHeight: true}, sheet.RowInfo())
+
+	require.True(t, sheet.NextRow())
+	require.Equal(t, RowInfo{Hidden: true, OutlineLevel: 2}, sheet.RowInfo())
+
+	require.True(t, sheet.NextRow())
+	require.Equal(t, RowInfo{Collapsed: true}, sheet.RowInfo())
+}
@@ -3,6 +3,7 @@ package xlsx
 import (
 	"archive/zip"
 	"io"
+	"reflect"
 	"strconv"
 	"time"
 
@@ -10,7 +11,11 @@ import (
 )
 
 type Sheet struct {
+	x             *Xlsx
+	name          string
+	file          *zip.File
 	zipReader     io.ReadCloser
+	closed        bool
 	decoder       *xml.Decoder
 	sharedStrings sharedStrings
 	styles        *styleSheet
@@ -19,38 +24,196 @@ type Sheet struct {
 
 	isFutureRow bool
 	futureRow   int
+	rowInfo     RowInfo
+	nextRowInfo RowInfo
+	columns     []Column
+	head        sheetHead
 
 	cellValue  []byte
-	cellType   cellType
+	cellType   CellType
 	cellFormat int
+	cellRuns   []RichTextRun
+	formula    cellFormula
+	richText   bool
+
+	skippedFormula cellFormula
+	sharedFormulas map[int]sharedFormula
+
+	header      *sheetHeader
+	decoders    map[reflect.Type]*rowDecoder
+	rowStarted  bool
+	rowBuffered bool
+	rowValues   []string
+	rowErr      error
+
+	sheetDataEnd bool
+	tail         *sheetTail
+	merge        *mergeState
+	visible      *visibleState
+	bounds       *Range
+	comments     *commentIndex
+	threads      *threadIndex
 
 	Row int
 	Col int
 }
 
-type cellType int
+type CellType int
 
 const (
-	cellTypeString cellType = iota
-	cellTypeInline
-	cellTypeFormula
-	cellTypeBool
-	cellTypeError
-	cellTypeDate
-	cellTypeNumeric
+	CellTypeString CellType = iota
+	CellTypeInline
+	CellTypeFormula
+	CellTypeBool
+	CellTypeError
+	CellTypeDate
+	CellTypeNumeric
 )
 
-func newSheetReader(zipFile *zip.File, sharedStrings sharedStrings, styles *styleSheet, date1904 bool) (*Sheet, error) {
+func (t CellType) String() string {
+	switch t {
+	case CellTypeString:
+		return "shared string"
+	case CellTypeInline:
+		return "inline string"
+	case CellTypeFormula:
+		return "formula string"
+	case CellTypeBool:
+		return "bool"
+	case CellTypeError:
+		return "error"
+	case CellTypeDate:
+		return "date"
+	case CellTypeNumeric:
+		return "numeric"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrorValue is the value of a cell holding an error such as #DIV/0!.
+type ErrorValue string
+
+const (
+	ErrorValueNull        ErrorValue = "#NULL!"
+	ErrorValueDiv0        ErrorValue = "#DIV/0!"
+	ErrorValueValue       ErrorValue = "#VALUE!"
+	ErrorValueRef         ErrorValue = "#REF!"
+	ErrorValueName        ErrorValue = "#NAME?"
+	ErrorValueNum         ErrorValue = "#NUM!"
+	ErrorValueNA          ErrorValue = "#N/A"
+	ErrorValueGettingData ErrorValue = "#GETTING_DATA"
+	ErrorValueSpill       ErrorValue = "#SPILL!"
+	ErrorValueCalc        ErrorValue = "#CALC!"
+)
+
+func (e ErrorValue) String() string {
+	return string(e)
+}
+
+func newSheetReader(x *Xlsx, name string, zipFile *zip.File, opts []SheetOption) (*Sheet, error) {
+	var options sheetOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	reader, err := zipFile.Open()
 	if err != nil {
 		return nil, err
 	}
 
-	decoder := xml.NewDecoder(reader, []xml.TagAttrs{
+	tagAttrs := sheetTagAttrs()
+	if options.richText {
+		tagAttrs = append(tagAttrs, fontTagAttrs()...)
+	}
+	decoder := xml.NewDecoder(reader, tagAttrs)
+	sheet := &Sheet{
+		x:             x,
+		name:          name,
+		file:          zipFile,
+		zipReader:     reader,
+		decoder:       decoder,
+		sharedStrings: x.sharedStrings,
+		styles:        x.styles,
+		date1904:      x.date1904,
+		cellValue:     make([]byte, 0),
+		bounds:        options.bounds,
+		richText:      options.richText,
+	}
+
+	err = sheet.skipToSheetData()
+	if err != nil {
+		_ = reader.Close()
+		return nil, err
+	}
+
+	if options.mergedCells {
+		err = sheet.initMerge(options.mergedCellValues)
+		if err != nil {
+			_ = reader.Close()
+			return nil, err
+		}
+	}
+
+	if options.visibleOnly {
+		err = sheet.initVisible()
+		if err != nil {
+			_ = reader.Close()
+			return nil, err
+		}
+	}
+
+	return sheet, nil
+}
+
+func sheetTagAttrs() []xml.TagAttrs {
+	return append([]xml.TagAttrs{
 		{
 			Name: "row",
 			Attr: []xml.TagAttr{
 				{Name: "r"},
+				{Name: "ht"},
+				{Name: "customHeight"},
+				{Name: "hidden"},
+				{Name: "outlineLevel"},
+				{Name: "collapsed"},
+			},
+		},
+		{
+			Name: "dimension",
+			Attr: []xml.TagAttr{
+				{Name: "ref"},
+			},
+		},
+		{
+			Name: "pane",
+			Attr: []xml.TagAttr{
+				{Name: "xSplit"},
+				{Name: "ySplit"},
+				{Name: "topLeftCell"},
+				{Name: "state"},
+			},
+		},
+		{
+			Name: "sheetFormatPr",
+			Attr: []xml.TagAttr{
+				{Name: "defaultRowHeight"},
+				{Name: "defaultColWidth"},
+				{Name: "baseColWidth"},
+			},
+		},
+		{
+			Name: "col",
+			Attr: []xml.TagAttr{
+				{Name: "min"},
+				{Name: "max"},
+				{Name: "width"},
+				{Name: "customWidth"},
+				{Name: "bestFit"},
+				{Name: "hidden"},
+				{Name: "outlineLevel"},
+				{Name: "collapsed"},
+				{Name: "style"},
 			},
 		},
 		{
@@ -61,34 +224,84 @@ func newSheetReader(zipFile *zip.File, sharedStrings sharedStrings, styles *styl
 				{Name: "r"},
 			},
 		},
-	})
-	sheet := &Sheet{
-		zipReader:     reader,
-		decoder:       decoder,
-		sharedStrings: sharedStrings,
-		styles:        styles,
-		date1904:      date1904,
-		cellValue:     make([]byte, 0),
-	}
-
-	err = sheet.skipToSheetData()
-	if err != nil {
-		_ = reader.Close()
-		return nil, err
-	}
-
-	return sheet, nil
+		{
+			Name: "f",
+			Attr: []xml.TagAttr{
+				{Name: "t"},
+				{Name: "si"},
+				{Name: "ref"},
+				{Name: "r1"},
+				{Name: "r2"},
+				{Name: "dt2D"},
+				{Name: "dtr"},
+			},
+		},
+		{
+			Name: "mergeCell",
+			Attr: []xml.TagAttr{
+				{Name: "ref"},
+			},
+		},
+		{
+			Name: "hyperlink",
+			Attr: []xml.TagAttr{
+				{Name: "ref"},
+				{Name: "id"},
+				{Name: "location"},
+				{Name: "tooltip"},
+				{Name: "display"},
+			},
+		},
+		{
+			Name: "dataValidation",
+			Attr: []xml.TagAttr{
+				{Name: "type"},
+				{Name: "operator"},
+				{Name: "errorStyle"},
+				{Name: "allowBlank"},
+				{Name: "showDropDown"},
+				{Name: "showInputMessage"},
+				{Name: "showErrorMessage"},
+				{Name: "errorTitle"},
+				{Name: "error"},
+				{Name: "promptTitle"},
+				{Name: "prompt"},
+				{Name: "sqref"},
+			},
+		},
+	}, append(autoFilterTagAttrs(), conditionalFormatTagAttrs()...)...)
 }
 
 func (s *Sheet) skipToSheetData() error {
+	views := 0
 	for t, err := s.decoder.Token(); err == nil; t, err = s.decoder.Token() {
 		switch token := t.(type) {
 		case *xml.StartElement:
 			switch token.Name.Local {
-			case "worksheet":
+			case "worksheet", "cols", "sheetViews":
 				//
+			case "sheetView":
+				// the pane is read from the first view only
+				views++
+				if views > 1 {
+					if er := s.decoder.Skip(); er != nil {
+						return er
+					}
+				}
 			case "sheetData":
 				return nil
+			case "dimension":
+				s.head.parseDimension(token.Attr)
+			case "pane":
+				s.head.parsePane(token.Attr)
+			case "sheetFormatPr":
+				s.head.parseFormat(token.Attr)
+			case "col":
+				c, er := parseColumn(token.Attr)
+				if er != nil {
+					return er
+				}
+				s.columns = append(s.columns, c)
 			default:
 				if er := s.decoder.Skip(); er != nil {
 					return er
@@ -99,7 +312,15 @@ func (s *Sheet) skipToSheetData() error {
 	return nil
 }
 
+func (s *Sheet) Name() string {
+	return s.name
+}
+
 func (s *Sheet) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
 	return s.zipReader.Close()
 }
 
@@ -117,33 +338,98 @@ func (s *Sheet) SkipRow() error {
 }
 
 func (s *Sheet) NextRow() bool {
+	for s.startRow() {
+		if s.bounds != nil {
+			if s.Row > s.bounds.LastRow {
+				s.err = io.EOF
+				return false
+			}
+			if s.Row < s.bounds.FirstRow {
+				if s.merge != nil {
+					for s.readCell() {
+					}
+				}
+				continue
+			}
+		}
+
+		if s.visible == nil || s.visibleRow() {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Sheet) startRow() bool {
 	if s.err != nil {
 		return false
 	}
 
+	s.rowStarted = false
+	s.rowBuffered = false
+	s.rowErr = nil
+
 	if s.isFutureRow {
 		s.isFutureRow = false
 		s.Row = s.futureRow
-		return true
+	} else {
+		row, err := s.nextRow()
+		if err != nil {
+			s.err = err
+			return false
+		}
+		s.Row = row
 	}
+	s.rowInfo = s.nextRowInfo
 
-	row, err := s.nextRow()
-	if err != nil {
-		s.err = err
-		return false
+	if s.merge != nil {
+		s.merge.startRow(s.Row)
 	}
-
-	s.Row = row
 	return true
 }
 
 func (s *Sheet) NextCell() bool {
-	s.cellType = cellTypeNumeric
+	s.rowStarted = true
+
+	for s.nextRowCell() {
+		if s.bounds == nil || (s.Col >= s.bounds.FirstCol && s.Col <= s.bounds.LastCol) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Sheet) nextRowCell() bool {
+	if s.visible != nil {
+		return s.nextVisibleCell()
+	}
+	return s.readCell()
+}
+
+func (s *Sheet) readCell() bool {
+	if s.merge != nil {
+		if s.merge.fill {
+			return s.nextMergedCell()
+		}
+		if !s.nextCell() {
+			return false
+		}
+		s.applyMerge()
+		return true
+	}
+
+	return s.nextCell()
+}
+
+func (s *Sheet) nextCell() bool {
+	s.cellType = CellTypeNumeric
 	s.cellFormat = 0
 
 	isV := false
 	isIs := false
 	isT := false
+	isF := false
+	isR := false
 
 	t, err := s.decoder.Token()
 	for err == nil {
@@ -157,19 +443,19 @@ func (s *Sheet) NextCell() bool {
 					case "t":
 						switch string(a.Value.Bytes()) {
 						case "s":
-							s.cellType = cellTypeString
+							s.cellType = CellTypeString
 						case "inlineStr":
-							s.cellType = cellTypeInline
+							s.cellType = CellTypeInline
 						case "b":
-							s.cellType = cellTypeBool
+							s.cellType = CellTypeBool
 						case "e":
-							s.cellType = cellTypeError
+							s.cellType = CellTypeError
 						case "str":
-							s.cellType = cellTypeFormula
+							s.cellType = CellTypeFormula
 						case "d":
-							s.cellType = cellTypeDate
+							s.cellType = CellTypeDate
 						case "n":
-							s.cellType = cellTypeNumeric
+							s.cellType = CellTypeNumeric
 						}
 					case "s":
 						s.cellFormat, err = strconv.Atoi(a.Value.String())
@@ -188,12 +474,41 @@ func (s *Sheet) NextCell() bool {
 
 				s.Col = columnIndex(cell)
 				s.cellValue = s.cellValue[:0]
+				s.cellRuns = s.cellRuns[:0]
+				s.formula.reset()
+			case "f":
+				isF = true
+				er := s.formula.parseAttrs(token.Attr)
+				if er != nil {
+					s.err = er
+					return false
+				}
 			case "v":
 				isV = true
 			case "is":
 				isIs = true
 			case "t":
 				isT = true
+			case "r":
+				if isIs && s.richText {
+					isR = true
+					s.cellRuns = append(s.cellRuns, RichTextRun{})
+				}
+			case "rPr":
+				if isR {
+					font, er := readFont(s.decoder)
+					if er != nil {
+						s.err = er
+						return false
+					}
+					s.x.colors.resolve(&font.Color)
+					s.cellRuns[len(s.cellRuns)-1].Font = font
+				}
+			case "rPh":
+				if er := s.decoder.Skip(); er != nil {
+					s.err = er
+					return false
+				}
 			}
 		case *xml.EndElement:
 			switch token.Name.Local {
@@ -211,19 +526,33 @@ func (s *Sheet) NextCell() bool {
 					s.futureRow = row
 					return false
 				}
+			case "f":
+				isF = false
+				if s.formula.isSharedMaster() {
+					s.addSharedFormula(&s.formula, s.Row, s.Col)
+				}
 			case "v":
 				isV = false
 			case "is":
 				isIs = false
 			case "t":
 				isT = false
+			case "r":
+				isR = false
 			}
 		case *xml.CharData:
+			if isF {
+				s.formula.text = append(s.formula.text, token.Value...)
+				break
+			}
 			if !(isV || (isIs && isT)) {
 				break
 			}
 
 			s.cellValue = append(s.cellValue, token.Value...)
+			if isR && isT {
+				s.cellRuns[len(s.cellRuns)-1].Text += string(token.Value)
+			}
 		}
 
 		t, err = s.decoder.Token()
@@ -234,19 +563,37 @@ func (s *Sheet) NextCell() bool {
 }
 
 func (s *Sheet) nextRow() (int, error) {
+	var cell []byte
 	t, err := s.decoder.Token()
 	for err == nil {
 		switch token := t.(type) {
 		case *xml.StartElement:
-			if token.Name.Local == "row" {
+			switch token.Name.Local {
+			case "row":
 				row, er := parseRowNumber(token.Attr)
 				if er != nil {
 					return 0, er
 				}
+				s.nextRowInfo, er = parseRowInfo(token.Attr)
+				if er != nil {
+					return 0, er
+				}
 				return row - 1, nil
+			case "c":
+				for _, a := range token.Attr {
+					if a.Name.Local == "r" {
+						cell = append(cell[:0], a.Value.Bytes()...)
+					}
+				}
+			case "f":
+				er := s.skipFormula(token.Attr, cell)
+				if er != nil {
+					return 0, er
+				}
 			}
 		case *xml.EndElement:
 			if token.Name.Local == "sheetData" {
+				s.sheetDataEnd = true
 				return 0, io.EOF
 			}
 		}
@@ -266,8 +613,59 @@ func parseRowNumber(attrs []xml.Attr) (int, error) {
 	return 0, ErrRowMissingR
 }
 
+func (s *Sheet) CellType() CellType {
+	return s.cellType
+}
+
+// Value returns the current cell value as string, float64, bool, time.Time
+// or ErrorValue depending on the cell type and number format. It returns nil
+// for cells without a value. Numeric cells are time.Time exactly when
+// CellIsDate reports true.
+func (s *Sheet) Value() (any, error) {
+	switch s.cellType {
+	case CellTypeString:
+		return s.getSharedString()
+	case CellTypeInline, CellTypeFormula:
+		return string(s.cellValue), nil
+	}
+
+	if len(s.cellValue) == 0 {
+		return nil, nil
+	}
+
+	switch s.cellType {
+	case CellTypeBool:
+		switch string(s.cellValue) {
+		case "0":
+			return false, nil
+		case "1":
+			return true, nil
+		}
+		return nil, ErrInvalidBool
+	case CellTypeError:
+		return ErrorValue(s.cellValue), nil
+	case CellTypeDate:
+		t, err := s.CellTime()
+		if err != nil {
+			return nil, err
+		}
+		return t, nil
+	case CellTypeNumeric:
+		val, err := strconv.ParseFloat(string(s.cellValue), 64)
+		if err != nil {
+			return nil, err
+		}
+		if s.CellNumberFormat().Kind.IsDate() && validExcelTime(val) {
+			return timeFromExcelTime(val, s.date1904), nil
+		}
+		return val, nil
+	default:
+		return nil, ErrUnknownCellType
+	}
+}
+
 func (s *Sheet) CellValue() (string, error) {
-	if s.cellType == cellTypeString {
+	if s.cellType == CellTypeString {
 		return s.getSharedString()
 	}
 
@@ -275,7 +673,7 @@ func (s *Sheet) CellValue() (string, error) {
 }
 
 func (s *Sheet) CellFloat() (float64, error) {
-	if s.cellType == cellTypeString {
+	if s.cellType == CellTypeString {
 		str, err := s.getSharedString()
 		if err != nil {
 			return 0, err
@@ -283,12 +681,15 @@ func (s *Sheet) CellFloat() (float64, error) {
 
 		return strconv.ParseFloat(str, 64)
 	}
+	if s.cellType == CellTypeDate {
+		return s.dateSerial()
+	}
 
 	return strconv.ParseFloat(string(s.cellValue), 64)
 }
 
 func (s *Sheet) CellInt() (int, error) {
-	if s.cellType == cellTypeString {
+	if s.cellType == CellTypeString {
 		str, err := s.getSharedString()
 		if err != nil {
 			return 0, err
@@ -296,21 +697,79 @@ func (s *Sheet) CellInt() (int, error) {
 
 		return strconv.Atoi(str)
 	}
+	if s.cellType == CellTypeDate {
+		val, err := s.dateSerial()
+		if err != nil {
+			return 0, err
+		}
+		return strconv.Atoi(strconv.FormatFloat(val, 'f', -1, 64))
+	}
 
 	return strconv.Atoi(string(s.cellValue))
 }
 
+// dateSerial returns the serial date of a t="d" cell.
+func (s *Sheet) dateSerial() (float64, error) {
+	t, err := parseISODate(string(s.cellValue), s.date1904)
+	if err != nil {
+		return 0, err
+	}
+	return excelTimeFromTime(t, s.date1904), nil
+}
+
+// CellTime returns the cell value as time in UTC. The value is a serial date
+// of the workbook date system, see CellTimeIn for the conversion.
 func (s *Sheet) CellTime() (time.Time, error) {
+	return s.CellTimeIn(time.UTC)
+}
+
+// CellTimeIn returns the cell value as time in loc. Excel dates have no time
+// zone, the wall clock time of the cell is kept. The serial is rounded to
+// milliseconds and follows the 1900 leap year bug: serials 1 to 59 are
+// January 1 to February 28 1900, serial 60 is the nonexistent February 29
+// and returned as February 28. ISO 8601 values of date cells keep their wall
+// clock time as well, an offset is dropped the way Excel shows the value.
+// A nil loc is UTC.
+func (s *Sheet) CellTimeIn(loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	if s.cellType == CellTypeDate {
+		t, err := parseISODate(string(s.cellValue), s.date1904)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return wallTime(t, loc), nil
+	}
+
 	val, err := s.CellFloat()
 	if err != nil {
 		return time.Time{}, err
 	}
-	return timeFromExcelTime(val, s.date1904), nil
+	if !validExcelTime(val) {
+		return time.Time{}, ErrTimeOutOfRange
+	}
+
+	t := timeFromExcelTime(val, s.date1904)
+	if loc == time.UTC {
+		return t, nil
+	}
+	return wallTime(t, loc), nil
+}
+
+// CellDuration returns the cell value as elapsed time, like a [h]:mm:ss
+// value. The value is a number of days rounded to milliseconds.
+func (s *Sheet) CellDuration() (time.Duration, error) {
+	val, err := s.CellFloat()
+	if err != nil {
+		return 0, err
+	}
+	return durationFromExcelTime(val)
 }
 
 func (s *Sheet) CellFormatValue() (string, error) {
 	switch s.cellType {
-	case cellTypeString:
+	case CellTypeString:
 		format := s.styles.getFormat(s.cellFormat)
 		str, err := s.getSharedString()
 		if err != nil {
@@ -321,14 +780,14 @@ func (s *Sheet) CellFormatValue() (string, error) {
 			return val, format.parseEncounteredError
 		}
 		return val, err
-	case cellTypeInline, cellTypeFormula:
+	case CellTypeInline, CellTypeFormula:
 		format := s.styles.getFormat(s.cellFormat)
 		val, err := format.text(string(s.cellValue))
 		if format.parseEncounteredError != nil {
 			return val, format.parseEncounteredError
 		}
 		return val, err
-	case cellTypeBool:
+	case CellTypeBool:
 		if string(s.cellValue) == "0" {
 			return "FALSE", nil
 		}
@@ -336,9 +795,24 @@ func (s *Sheet) CellFormatValue() (string, error) {
 			return "TRUE", nil
 		}
 		return string(s.cellValue), ErrInvalidBool
-	case cellTypeError, cellTypeDate:
+	case CellTypeError:
 		return string(s.cellValue), nil
-	case cellTypeNumeric:
+	case CellTypeDate:
+		// without a date or time format the ISO text reads better than a serial
+		if kind := s.CellNumberFormat().Kind; !kind.IsDate() && kind != NumberFormatDuration {
+			return string(s.cellValue), nil
+		}
+		val, err := s.dateSerial()
+		if err != nil {
+			return string(s.cellValue), err
+		}
+		format := s.styles.getFormat(s.cellFormat)
+		str, err := format.numeric(strconv.FormatFloat(val, 'f', -1, 64), s.date1904)
+		if format.parseEncounteredError != nil {
+			return str, format.parseEncounteredError
+		}
+		return str, err
+	case CellTypeNumeric:
 		format := s.styles.getFormat(s.cellFormat)
 		val, err := format.numeric(string(s.cellValue), s.date1904)
 		if format.parseEncounteredError != nil {
@@ -0,0 +1,102 @@
+package xlsx
+
+import (
+	"strconv"
+
+	"github.com/anfilat/xlsx-sax/internal/xml"
+)
+
+// defaultBaseColWidth is the baseColWidth value when sheetFormatPr omits it.
+const defaultBaseColWidth = 8
+
+// sheetHead holds the worksheet elements that precede sheetData. Malformed
+// values are ignored as they only describe the sheet.
+type sheetHead struct {
+	dimension    Range
+	hasDimension bool
+
+	paneFrozen bool
+	frozenRows int
+	frozenCols int
+
+	defaultRowHeight float64
+	defaultColWidth  float64
+	baseColWidth     float64
+}
+
+func (h *sheetHead) parseDimension(attrs []xml.Attr) {
+	for _, a := range attrs {
+		if a.Name.Local == "ref" {
+			r, err := ParseRange(a.Value.String())
+			if err == nil {
+				h.dimension = r
+				h.hasDimension = true
+			}
+		}
+	}
+}
+
+// parsePane reads the pane of the first sheet view, later views belong to
+// other workbook windows.
+func (h *sheetHead) parsePane(attrs []xml.Attr) {
+	var xSplit, ySplit float64
+	for _, a := range attrs {
+		switch a.Name.Local {
+		case "xSplit":
+			xSplit, _ = strconv.ParseFloat(a.Value.String(), 64)
+		case "ySplit":
+			ySplit, _ = strconv.ParseFloat(a.Value.String(), 64)
+		case "state":
+			state := a.Value.String()
+			h.paneFrozen = state == "frozen" || state == "frozenSplit"
+		}
+	}
+	if h.paneFrozen {
+		h.frozenCols = int(xSplit)
+		h.frozenRows = int(ySplit)
+	}
+}
+
+func (h *sheetHead) parseFormat(attrs []xml.Attr) {
+	for _, a := range attrs {
+		switch a.Name.Local {
+		case "defaultRowHeight":
+			h.defaultRowHeight, _ = strconv.ParseFloat(a.Value.String(), 64)
+		case "defaultColWidth":
+			h.defaultColWidth, _ = strconv.ParseFloat(a.Value.String(), 64)
+		case "baseColWidth":
+			h.baseColWidth, _ = strconv.ParseFloat(a.Value.String(), 64)
+		}
+	}
+}
+
+// Dimension returns the used range declared by the sheet. It is not checked
+// against the cells and may be missing.
+func (s *Sheet) Dimension() (Range, bool) {
+	return s.head.dimension, s.head.hasDimension
+}
+
+// FrozenPanes returns the number of frozen rows at the top and columns on the
+// left of the sheet, both zero when panes are not frozen.
+func (s *Sheet) FrozenPanes() (rows, cols int) {
+	return s.head.frozenRows, s.head.frozenCols
+}
+
+// DefaultRowHeight returns the height in points of rows without their own
+// height, zero when the sheet doesn't declare it.
+func (s *Sheet) DefaultRowHeight() float64 {
+	return s.head.defaultRowHeight
+}
+
+// DefaultColumnWidth returns the width in characters of columns without their
+// own width. Without defaultColWidth it falls back to baseColWidth, which
+// doesn't include the cell padding.
+func (s *Sheet) DefaultColumnWidth() float64 {
+	if s.head.defaultColWidth > 0 {
+		return s.head.defaultColWidth
+	}
+	if s.head.baseColWidth > 0 {
+		return s.head.baseColWidth
+	}
+	return defaultBaseColWidth
+}
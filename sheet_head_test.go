@@ -0,0 +1,72 @@
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSheetHead(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<dimension ref="A1:C20"/>` +
+			`<sheetViews><sheetView tabSelected="1" workbookViewId="0"><pane xSplit="1" ySplit="2" topLeftCell="B3" activePane="bottomRight" state="frozen"/><selection pane="bottomRight" activeCell="B3" sqref="B3"/></sheetView>` +
+			`<sheetView workbookViewId="1"><pane ySplit="5" topLeftCell="A6" state="frozen"/></sheetView></sheetViews>` +
+			`<sheetFormatPr baseColWidth="10" defaultRowHeight="16" x14ac:dyDescent="0.2" xmlns:x14ac="http://schemas.microsoft.com/office/spreadsheetml/2009/9/ac"/>` +
+			`<sheetData><row r="1"><c r="A1"><v>1</v></c></row></sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	r, ok := sheet.Dimension()
+	require.True(t, ok)
+	require.Equal(t, Range{FirstRow: 0, FirstCol: 0, LastRow: 19, LastCol: 2}, r)
+
+	rows, cols := sheet.FrozenPanes()
+	require.Equal(t, 2, rows)
+	require.Equal(t, 1, cols)
+
+	require.Equal(t, 16.0, sheet.DefaultRowHeight())
+	require.Equal(t, 10.0, sheet.DefaultColumnWidth())
+
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextCell())
+}
+
+func TestSheetHeadMissing(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetViews><sheetView workbookViewId="0"><pane xSplit="2000" ySplit="1500" topLeftCell="C4"/></sheetView></sheetViews>` +
+			`<sheetData/>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	_, ok := sheet.Dimension()
+	require.False(t, ok)
+
+	rows, cols := sheet.FrozenPanes()
+	require.Zero(t, rows)
+	require.Zero(t, cols)
+
+	require.Zero(t, sheet.DefaultRowHeight())
+	require.Equal(t, 8.0, sheet.DefaultColumnWidth())
+}
+
+func TestSheetHeadSecondView(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetViews><sheetView workbookViewId="0"/>` +
+			`<sheetView workbookViewId="1"><pane ySplit="5" topLeftCell="A6" state="frozen"/></sheetView></sheetViews>` +
+			`<sheetData/>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	rows, cols := sheet.FrozenPanes()
+	require.Zero(t, rows)
+	require.Zero(t, cols)
+}
@@ -0,0 +1,174 @@
+package xlsx
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/anfilat/xlsx-sax/internal/xml"
+)
+
+type SheetKind int
+
+const (
+	SheetKindWorksheet SheetKind = iota
+	SheetKindChartsheet
+	SheetKindDialogsheet
+	SheetKindMacrosheet
+)
+
+func (k SheetKind) String() string {
+	switch k {
+	case SheetKindWorksheet:
+		return "worksheet"
+	case SheetKindChartsheet:
+		return "chartsheet"
+	case SheetKindDialogsheet:
+		return "dialogsheet"
+	case SheetKindMacrosheet:
+		return "macrosheet"
+	}
+	return "unknown"
+}
+
+type SheetState int
+
+const (
+	SheetVisible SheetState = iota
+	SheetHidden
+	SheetVeryHidden
+)
+
+func (s SheetState) String() string {
+	switch s {
+	case SheetVisible:
+		return "visible"
+	case SheetHidden:
+		return "hidden"
+	case SheetVeryHidden:
+		return "veryHidden"
+	}
+	return "unknown"
+}
+
+var sheetKindByRelType = map[string]SheetKind{
+	relTypeWorksheet:      SheetKindWorksheet,
+	relTypeChartsheet:     SheetKindChartsheet,
+	relTypeDialogsheet:    SheetKindDialogsheet,
+	relTypeMacrosheet:     SheetKindMacrosheet,
+	relTypeIntlMacrosheet: SheetKindMacrosheet,
+}
+
+func parseSheetState(state string) SheetState {
+	switch state {
+	case "hidden":
+		return SheetHidden
+	case "veryHidden":
+		return SheetVeryHidden
+	}
+	return SheetVisible
+}
+
+// SheetInfo describes a sheet of the workbook. ID is the sheetId attribute,
+// RelID the relationship id and Path the package part of the sheet. TabColor
+// is nil when the sheet has no tab color.
+type SheetInfo struct {
+	Name     string
+	ID       int
+	RelID    string
+	Path     string
+	Kind     SheetKind
+	State    SheetState
+	TabColor *Color
+}
+
+// SheetInfos returns all sheets of the workbook in order, including chartsheets,
+// dialog sheets, macro sheets and hidden sheets. Tab colors are read from the
+// sheet parts on the first call, a part that can't be read has no tab color.
+func (x *Xlsx) SheetInfos() []SheetInfo {
+	x.loadTabColors()
+
+	result := make([]SheetInfo, len(x.sheets))
+	for i, info := range x.sheets {
+		if info.TabColor != nil {
+			c := *info.TabColor
+			info.TabColor = &c
+		}
+		result[i] = info
+	}
+	return result
+}
+
+func (x *Xlsx) loadTabColors() {
+	x.tabColorsOnce.Do(func() {
+		for i := range x.sheets {
+			zipFile, ok := x.files[x.sheets[i].Path]
+			if !ok {
+				continue
+			}
+
+			reader, err := zipFile.Open()
+			if err != nil {
+				continue
+			}
+			c, _ := readTabColor(reader)
+			_ = reader.Close()
+			if c != nil {
+				x.colors.resolve(c)
+				x.sheets[i].TabColor = c
+			}
+		}
+	})
+}
+
+// readTabColor reads the tab color from sheetPr, the first child of the
+// sheet root element.
+func readTabColor(reader io.Reader) (*Color, error) {
+	decoder := xml.NewDecoder(reader, []xml.TagAttrs{colorTagAttrs("tabColor")})
+
+	root := true
+	inSheetPr := false
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := t.(type) {
+		case *xml.StartElement:
+			switch {
+			case root:
+				root = false
+			case token.Name.Local == "sheetPr":
+				inSheetPr = true
+			case inSheetPr && token.Name.Local == "tabColor":
+				c := parseColor(token.Attr)
+				return &c, nil
+			case inSheetPr:
+				if er := decoder.Skip(); er != nil {
+					return nil, er
+				}
+			default:
+				return nil, nil
+			}
+		case *xml.EndElement:
+			if token.Name.Local == "sheetPr" {
+				return nil, nil
+			}
+		}
+	}
+}
+
+// OpenSheetByID opens the worksheet with the given sheetId.
+func (x *Xlsx) OpenSheetByID(id int, opts ...SheetOption) (*Sheet, error) {
+	for _, info := range x.sheets {
+		if info.ID != id {
+			continue
+		}
+		if info.Kind != SheetKindWorksheet {
+			return nil, fmt.Errorf("can not open sheet %d: %w", id, ErrNotWorksheet)
+		}
+		return newSheetReader(x, info.Name, x.files[info.Path], opts)
+	}
+
+	return nil, fmt.Errorf("can not find sheet with id %d: %w", id, ErrSheetNotFound)
+}
@@ -0,0 +1,62 @@
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSheetInfo(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>` +
+			`<sheet name="Data" sheetId="3" r:id="rId1"/>` +
+			`<sheet name="Chart" sheetId="5" state="veryHidden" r:id="rId2"/>` +
+			`<sheet name="Helper" sheetId="7" state="hidden" r:id="rId3"/>` +
+			`</sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/chartsheet" Target="/xl/chartsheets/sheet1.xml"/>` +
+			`<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet2.xml"/>` +
+			`</Relationships>`,
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetPr><outlinePr summaryBelow="0"/><tabColor rgb="FFFF0000"/></sheetPr><sheetData><row r="1"><c r="A1"><v>1</v></c></row></sheetData>`),
+		"xl/worksheets/sheet2.xml": testWorksheet(`<dimension ref="A1"/><sheetData><row r="1"><c r="A1"><v>2</v></c></row></sheetData>`),
+		"xl/chartsheets/sheet1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<chartsheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetPr><tabColor theme="4" tint="0.4"/></sheetPr><sheetViews><sheetView workbookViewId="0"/></sheetViews></chartsheet>`,
+	})
+
+	require.Equal(t, []SheetInfo{
+		{
+			Name: "Data", ID: 3, RelID: "rId1", Path: "xl/worksheets/sheet1.xml",
+			Kind: SheetKindWorksheet, State: SheetVisible,
+			TabColor: &Color{Kind: ColorRGB, RGB: "FFFF0000", ARGB: "FFFF0000"},
+		},
+		{
+			Name: "Chart", ID: 5, RelID: "rId2", Path: "xl/chartsheets/sheet1.xml",
+			Kind: SheetKindChartsheet, State: SheetVeryHidden,
+			TabColor: &Color{Kind: ColorTheme, Theme: 4, Tint: 0.4},
+		},
+		{
+			Name: "Helper", ID: 7, RelID: "rId3", Path: "xl/worksheets/sheet2.xml",
+			Kind: SheetKindWorksheet, State: SheetHidden,
+		},
+	}, xlsx.SheetInfos())
+	require.Equal(t, []string{"Data", "Helper"}, xlsx.SheetNames())
+
+	sheet, err := xlsx.OpenSheetByID(7)
+	require.NoError(t, err)
+	require.Equal(t, "Helper", sheet.Name())
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextCell())
+	v, err := sheet.CellValue()
+	require.NoError(t, err)
+	require.Equal(t, "2", v)
+	require.NoError(t, sheet.Close())
+
+	_, err = xlsx.OpenSheetByID(5)
+	require.ErrorIs(t, err, ErrNotWorksheet)
+
+	_, err = xlsx.OpenSheetByID(1)
+	require.ErrorIs(t, err, ErrSheetNotFound)
+}
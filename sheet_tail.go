@@ -0,0 +1,168 @@
+package xlsx
+
+import (
+	"errors"
+	"io"
+
+	"github.com/anfilat/xlsx-sax/internal/xml"
+)
+
+// sheetTail holds the worksheet elements that follow sheetData.
+type sheetTail struct {
+	mergedRanges       []Range
+	hyperlinks         []Hyperlink
+	hyperlinkRIDs      []string
+	hyperlinkIndex     *hyperlinkIndex
+	validations        []DataValidation
+	autoFilter         *autoFilter
+	conditionalFormats []ConditionalFormat
+}
+
+// loadTail returns the elements after sheetData. If the sheet has been read
+// up to the end of sheetData they are parsed in place, otherwise the sheet
+// part is scanned with a separate reader.
+func (s *Sheet) loadTail() (*sheetTail, error) {
+	if s.tail != nil {
+		return s.tail, nil
+	}
+
+	var (
+		tail *sheetTail
+		err  error
+	)
+	if s.sheetDataEnd && !s.closed {
+		tail, err = readSheetTail(s.decoder)
+	} else {
+		tail, err = s.scanTail()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.resolveHyperlinks(tail)
+	if err != nil {
+		return nil, err
+	}
+
+	s.tail = tail
+	return tail, nil
+}
+
+func (s *Sheet) scanTail() (*sheetTail, error) {
+	reader, err := s.file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	decoder := xml.NewDecoder(reader, sheetTagAttrs())
+	for t, err := decoder.Token(); err == nil; t, err = decoder.Token() {
+		switch token := t.(type) {
+		case *xml.StartElement:
+			switch token.Name.Local {
+			case "worksheet":
+				//
+			case "sheetData":
+				if er := decoder.Skip(); er != nil {
+					return nil, er
+				}
+				return readSheetTail(decoder)
+			default:
+				if er := decoder.Skip(); er != nil {
+					return nil, er
+				}
+			}
+		}
+	}
+
+	return &sheetTail{}, nil
+}
+
+func readSheetTail(decoder *xml.Decoder) (*sheetTail, error) {
+	tail := &sheetTail{}
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return tail, nil
+			}
+			return nil, err
+		}
+
+		token, ok := t.(*xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch token.Name.Local {
+		case "mergeCells", "hyperlinks", "dataValidations", "extLst", "ext":
+			//
+		case "mergeCell":
+			for _, a := range token.Attr {
+				if a.Name.Local == "ref" {
+					r, er := ParseRange(a.Value.String())
+					if er != nil {
+						return nil, er
+					}
+					tail.mergedRanges = append(tail.mergedRanges, r)
+				}
+			}
+		case "hyperlink":
+			h := Hyperlink{}
+			rid := ""
+			for _, a := range token.Attr {
+				switch a.Name.Local {
+				case "ref":
+					r, er := ParseRange(a.Value.String())
+					if er != nil {
+						return nil, er
+					}
+					h.Range = r
+				case "id":
+					rid = a.Value.String()
+				case "location":
+					h.Location = a.Value.String()
+				case "tooltip":
+					h.Tooltip = a.Value.String()
+				case "display":
+					h.Display = a.Value.String()
+				}
+			}
+			tail.hyperlinks = append(tail.hyperlinks, h)
+			tail.hyperlinkRIDs = append(tail.hyperlinkRIDs, rid)
+		case "autoFilter":
+			f, er := readAutoFilter(decoder, token)
+			if er != nil {
+				return nil, er
+			}
+			tail.autoFilter = f
+		case "dataValidation":
+			v, er := readDataValidation(decoder, token)
+			if er != nil {
+				return nil, er
+			}
+			tail.validations = append(tail.validations, v)
+		case "conditionalFormatting":
+			f, er := readConditionalFormat(decoder, token)
+			if er != nil {
+				return nil, er
+			}
+			tail.conditionalFormats = append(tail.conditionalFormats, f)
+		default:
+			if er := decoder.Skip(); er != nil {
+				return nil, er
+			}
+		}
+	}
+}
+
+// MergedRanges returns the merged cell ranges of the sheet.
+func (s *Sheet) MergedRanges() ([]Range, error) {
+	tail, err := s.loadTail()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Range, len(tail.mergedRanges))
+	copy(result, tail.mergedRanges)
+	return result, nil
+}
@@ -0,0 +1,69 @@
+package xlsx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testStyles = `<?xml version="1.0" encoding="UTF-8"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd hh:mm"/></numFmts>` +
+	`<cellXfs count="3"><xf numFmtId="0"/><xf numFmtId="14"/><xf numFmtId="164"/></cellXfs>` +
+	`</styleSheet>`
+
+func TestCellValueTypes(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/styles.xml": testStyles,
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData><row r="1">` +
+			`<c r="A1" t="inlineStr"><is><t>text</t></is></c>` +
+			`<c r="B1"><v>1.5</v></c>` +
+			`<c r="C1" s="1"><v>45352</v></c>` +
+			`<c r="D1" t="b"><v>0</v></c>` +
+			`<c r="E1" t="e"><v>#DIV/0!</v></c>` +
+			`<c r="F1" t="d"><v>2024-03-01T10:00:00</v></c>` +
+			`<c r="G1" t="str"><f>A1</f><v>text</v></c>` +
+			`<c r="H1" s="2"/>` +
+			`<c r="I1" t="e"><v>#SPILL!</v></c>` +
+			`</row></sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	expected := []struct {
+		typ   CellType
+		value any
+	}{
+		{CellTypeInline, "text"},
+		{CellTypeNumeric, 1.5},
+		{CellTypeNumeric, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{CellTypeBool, false},
+		{CellTypeError, ErrorValueDiv0},
+		{CellTypeDate, time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)},
+		{CellTypeFormula, "text"},
+		{CellTypeNumeric, nil},
+		{CellTypeError, ErrorValueSpill},
+	}
+
+	require.True(t, sheet.NextRow())
+	for i, exp := range expected {
+		require.True(t, sheet.NextCell())
+		require.Equal(t, i, sheet.Col)
+		require.Equal(t, exp.typ, sheet.CellType())
+
+		val, er := sheet.Value()
+		require.NoError(t, er)
+		require.Equal(t, exp.value, val)
+	}
+}
+
+func TestCellTypeString(t *testing.T) {
+	require.Equal(t, "shared string", CellTypeString.String())
+	require.Equal(t, "inline string", CellTypeInline.String())
+	require.Equal(t, "formula string", CellTypeFormula.String())
+	require.Equal(t, "numeric", CellTypeNumeric.String())
+	require.Equal(t, "unknown", CellType(-1).String())
+}
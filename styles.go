@@ -1,6 +1,7 @@
 package xlsx
 
 import (
+	"fmt"
 	"io"
 	"strconv"
 
@@ -9,26 +10,242 @@ import (
 
 type styleSheet struct {
 	numFormats    map[int]string
-	cellXfs       []int
+	fonts         []Font
+	fills         []Fill
+	borders       []Border
+	cellXfs       []cellXf
+	cellStyleXfs  []cellXf
+	namedStyles   []namedStyle
+	dxfs          []DifferentialFormat
+	indexedColors []string
 	parsedFormats map[string]*parsedNumFormat
 }
 
-func readStyleSheet(reader io.Reader) (*styleSheet, error) {
-	decoder := xml.NewDecoder(reader, []xml.TagAttrs{
-		{
+// cellXf is a cell format record, the s attribute of a cell indexes cellXfs.
+type cellXf struct {
+	numFmtID    int
+	fontID      int
+	fillID      int
+	borderID    int
+	xfID        int
+	quotePrefix bool
+	alignment   Alignment
+	protection  Protection
+}
+
+// namedStyle is a cellStyle record, xfID indexes cellStyleXfs.
+type namedStyle struct {
+	name          string
+	xfID          int
+	builtinID     int
+	hidden        bool
+	customBuiltin bool
+}
+
+// Fill is a cell fill. Pattern is the pattern type like solid or gray125,
+// Gradient is set for gradient fills.
+type Fill struct {
+	Pattern  string
+	FgColor  Color
+	BgColor  Color
+	Gradient *GradientFill
+}
+
+// GradientFill is a linear or path gradient. Left, Right, Top and Bottom
+// locate the inner rectangle of a path gradient.
+type GradientFill struct {
+	Type   string
+	Degree float64
+	Left   float64
+	Right  float64
+	Top    float64
+	Bottom float64
+	Stops  []GradientStop
+}
+
+type GradientStop struct {
+	Position float64
+	Color    Color
+}
+
+// BorderEdge is one side of a cell border. Style is empty when the side has
+// no border.
+type BorderEdge struct {
+	Style string
+	Color Color
+}
+
+type Border struct {
+	Left         BorderEdge
+	Right        BorderEdge
+	Top          BorderEdge
+	Bottom       BorderEdge
+	Diagonal     BorderEdge
+	DiagonalUp   bool
+	DiagonalDown bool
+}
+
+// Alignment is the cell text alignment. Empty Horizontal and Vertical mean
+// general and bottom alignment.
+type Alignment struct {
+	Horizontal   string
+	Vertical     string
+	WrapText     bool
+	ShrinkToFit  bool
+	Indent       int
+	TextRotation int
+	ReadingOrder int
+}
+
+type Protection struct {
+	Locked bool
+	Hidden bool
+}
+
+// CellStyle is the resolved format of a cell.
+type CellStyle struct {
+	NumFmtID     int
+	NumberFormat string
+	Font         Font
+	Fill         Fill
+	Border       Border
+	Alignment    Alignment
+	Protection   Protection
+	QuotePrefix  bool
+}
+
+// NamedStyle is a cell style of the workbook, like Normal, Heading 1 or
+// Total. BuiltinID is the id of a built-in style or -1 for custom styles,
+// Style is the format of its cellStyleXfs record.
+type NamedStyle struct {
+	Name          string
+	BuiltinID     int
+	Hidden        bool
+	CustomBuiltin bool
+	Style         CellStyle
+}
+
+// DifferentialFormat is a dxf record used by conditional formatting and
+// tables. It only holds the parts that override the cell format, the missing
+// parts are nil and NumberFormat is empty.
+type DifferentialFormat struct {
+	NumFmtID     int
+	NumberFormat string
+	Font         *Font
+	Fill         *Fill
+	Border       *Border
+	Alignment    *Alignment
+	Protection   *Protection
+}
+
+func styleTagAttrs() []xml.TagAttrs {
+	return append(fontTagAttrs(),
+		xml.TagAttrs{
 			Name: "numFmt",
 			Attr: []xml.TagAttr{
 				{Name: "formatCode"},
 				{Name: "numFmtId"},
 			},
 		},
-		{
+		xml.TagAttrs{
 			Name: "xf",
 			Attr: []xml.TagAttr{
 				{Name: "numFmtId"},
+				{Name: "fontId"},
+				{Name: "fillId"},
+				{Name: "borderId"},
+				{Name: "xfId"},
+				{Name: "quotePrefix"},
 			},
 		},
-	})
+		xml.TagAttrs{
+			Name: "alignment",
+			Attr: []xml.TagAttr{
+				{Name: "horizontal"},
+				{Name: "vertical"},
+				{Name: "wrapText"},
+				{Name: "shrinkToFit"},
+				{Name: "indent"},
+				{Name: "textRotation"},
+				{Name: "readingOrder"},
+			},
+		},
+		xml.TagAttrs{
+			Name: "protection",
+			Attr: []xml.TagAttr{
+				{Name: "locked"},
+				{Name: "hidden"},
+			},
+		},
+		xml.TagAttrs{
+			Name: "patternFill",
+			Attr: []xml.TagAttr{
+				{Name: "patternType"},
+			},
+		},
+		xml.TagAttrs{
+			Name: "gradientFill",
+			Attr: []xml.TagAttr{
+				{Name: "type"},
+				{Name: "degree"},
+				{Name: "left"},
+				{Name: "right"},
+				{Name: "top"},
+				{Name: "bottom"},
+			},
+		},
+		xml.TagAttrs{
+			Name: "stop",
+			Attr: []xml.TagAttr{
+				{Name: "position"},
+			},
+		},
+		xml.TagAttrs{
+			Name: "border",
+			Attr: []xml.TagAttr{
+				{Name: "diagonalUp"},
+				{Name: "diagonalDown"},
+			},
+		},
+		borderEdgeTagAttrs("left"),
+		borderEdgeTagAttrs("right"),
+		borderEdgeTagAttrs("top"),
+		borderEdgeTagAttrs("bottom"),
+		borderEdgeTagAttrs("diagonal"),
+		borderEdgeTagAttrs("start"),
+		borderEdgeTagAttrs("end"),
+		xml.TagAttrs{
+			Name: "rgbColor",
+			Attr: []xml.TagAttr{
+				{Name: "rgb"},
+			},
+		},
+		xml.TagAttrs{
+			Name: "cellStyle",
+			Attr: []xml.TagAttr{
+				{Name: "name"},
+				{Name: "xfId"},
+				{Name: "builtinId"},
+				{Name: "hidden"},
+				{Name: "customBuiltin"},
+			},
+		},
+		colorTagAttrs("fgColor"),
+		colorTagAttrs("bgColor"),
+	)
+}
+
+func borderEdgeTagAttrs(name string) xml.TagAttrs {
+	return xml.TagAttrs{
+		Name: name,
+		Attr: []xml.TagAttr{
+			{Name: "style"},
+		},
+	}
+}
+
+func readStyleSheet(reader io.Reader) (*styleSheet, error) {
+	decoder := xml.NewDecoder(reader, styleTagAttrs())
 
 	result := styleSheet{
 		numFormats:    make(map[int]string),
@@ -37,6 +254,7 @@ func readStyleSheet(reader io.Reader) (*styleSheet, error) {
 
 	isNumFmts := false
 	isCellXfs := false
+	isCellStyleXfs := false
 	for t, err := decoder.Token(); err == nil; t, err = decoder.Token() {
 		switch token := t.(type) {
 		case *xml.StartElement:
@@ -45,40 +263,77 @@ func readStyleSheet(reader io.Reader) (*styleSheet, error) {
 				isNumFmts = true
 			case "numFmt":
 				if isNumFmts {
-					id := 0
-					code := ""
-					for _, attr := range token.Attr {
-						switch attr.Name.Local {
-						case "formatCode":
-							code = attr.Value.String()
-						case "numFmtId":
-							id, err = strconv.Atoi(string(attr.Value.Bytes()))
-							if err != nil {
-								return nil, err
-							}
-						}
+					id, code, er := parseNumFmt(token.Attr)
+					if er != nil {
+						return nil, er
 					}
 
 					if id > builtinNumFormatsCount {
 						result.numFormats[id] = code
 					}
 				}
+			case "fonts", "fills", "borders", "colors", "indexedColors":
+				//
+			case "rgbColor":
+				for _, attr := range token.Attr {
+					if attr.Name.Local == "rgb" {
+						result.indexedColors = append(result.indexedColors, attr.Value.String())
+					}
+				}
+			case "font":
+				font, er := readFont(decoder)
+				if er != nil {
+					return nil, er
+				}
+				result.fonts = append(result.fonts, *font)
+			case "fill":
+				fill, er := readFill(decoder, "none")
+				if er != nil {
+					return nil, er
+				}
+				result.fills = append(result.fills, fill)
+			case "border":
+				border, er := readBorder(decoder, token.Attr)
+				if er != nil {
+					return nil, er
+				}
+				result.borders = append(result.borders, border)
 			case "cellXfs":
 				isCellXfs = true
+			case "cellStyleXfs":
+				isCellStyleXfs = true
 			case "xf":
-				if isCellXfs {
-					id := 0
-					for _, attr := range token.Attr {
-						switch attr.Name.Local {
-						case "numFmtId":
-							id, err = strconv.Atoi(string(attr.Value.Bytes()))
-							if err != nil {
-								return nil, err
-							}
-						}
+				switch {
+				case isCellXfs:
+					xf, er := readXf(decoder, token.Attr)
+					if er != nil {
+						return nil, er
+					}
+					result.cellXfs = append(result.cellXfs, xf)
+				case isCellStyleXfs:
+					xf, er := readXf(decoder, token.Attr)
+					if er != nil {
+						return nil, er
 					}
-					result.cellXfs = append(result.cellXfs, id)
+					result.cellStyleXfs = append(result.cellStyleXfs, xf)
+				default:
+					_ = decoder.Skip()
 				}
+			case "cellStyles", "dxfs":
+				//
+			case "cellStyle":
+				style, er := parseNamedStyle(token.Attr)
+				if er != nil {
+					return nil, er
+				}
+				result.namedStyles = append(result.namedStyles, style)
+				_ = decoder.Skip()
+			case "dxf":
+				dxf, er := result.readDxf(decoder)
+				if er != nil {
+					return nil, er
+				}
+				result.dxfs = append(result.dxfs, dxf)
 			case "styleSheet":
 				//
 			default:
@@ -90,6 +345,8 @@ func readStyleSheet(reader io.Reader) (*styleSheet, error) {
 				isNumFmts = false
 			case "cellXfs":
 				isCellXfs = false
+			case "cellStyleXfs":
+				isCellStyleXfs = false
 			}
 		}
 	}
@@ -97,21 +354,400 @@ func readStyleSheet(reader io.Reader) (*styleSheet, error) {
 	return &result, nil
 }
 
-func (s *styleSheet) getFormat(idx int) *parsedNumFormat {
-	code := ""
-	if idx >= 0 && idx < len(s.cellXfs) {
-		xf := s.cellXfs[idx]
-		if xf >= 0 && xf <= builtinNumFormatsCount {
-			code = builtinNumFormats[xf]
-		} else {
-			code = s.numFormats[xf]
+func parseNumFmt(attrs []xml.Attr) (int, string, error) {
+	var (
+		id   int
+		code string
+		err  error
+	)
+	for _, attr := range attrs {
+		switch attr.Name.Local {
+		case "formatCode":
+			code = attr.Value.String()
+		case "numFmtId":
+			id, err = strconv.Atoi(string(attr.Value.Bytes()))
+			if err != nil {
+				return 0, "", err
+			}
+		}
+	}
+	return id, code, nil
+}
+
+func parseNamedStyle(attrs []xml.Attr) (namedStyle, error) {
+	style := namedStyle{builtinID: -1}
+
+	var err error
+	for _, attr := range attrs {
+		switch attr.Name.Local {
+		case "name":
+			style.name = attr.Value.String()
+		case "xfId":
+			style.xfID, err = strconv.Atoi(attr.Value.String())
+		case "builtinId":
+			style.builtinID, err = strconv.Atoi(attr.Value.String())
+		case "hidden":
+			style.hidden = parseBoolAttr(attr.Value.Bytes())
+		case "customBuiltin":
+			style.customBuiltin = parseBoolAttr(attr.Value.Bytes())
+		}
+		if err != nil {
+			return style, fmt.Errorf("can not parse %s of cell style: %w", attr.Name.Local, ErrIncorrectStyles)
+		}
+	}
+	return style, nil
+}
+
+// readDxf reads the children of a dxf element including its end element.
+// A number format of a dxf is its own, it is not added to numFormats.
+func (s *styleSheet) readDxf(decoder *xml.Decoder) (DifferentialFormat, error) {
+	var dxf DifferentialFormat
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			return dxf, err
+		}
+
+		switch token := t.(type) {
+		case *xml.StartElement:
+			switch token.Name.Local {
+			case "font":
+				dxf.Font, err = readFont(decoder)
+			case "fill":
+				var fill Fill
+				fill, err = readFill(decoder, "solid")
+				dxf.Fill = &fill
+			case "border":
+				var border Border
+				border, err = readBorder(decoder, token.Attr)
+				dxf.Border = &border
+			case "numFmt":
+				dxf.NumFmtID, dxf.NumberFormat, err = parseNumFmt(token.Attr)
+				if err == nil && dxf.NumberFormat == "" {
+					dxf.NumberFormat = s.formatCode(dxf.NumFmtID)
+				}
+				if err == nil {
+					err = decoder.Skip()
+				}
+			case "alignment":
+				var alignment Alignment
+				alignment, err = parseAlignment(token.Attr)
+				dxf.Alignment = &alignment
+				if err == nil {
+					err = decoder.Skip()
+				}
+			case "protection":
+				dxf.Protection = &Protection{Locked: true}
+				for _, attr := range token.Attr {
+					switch attr.Name.Local {
+					case "locked":
+						dxf.Protection.Locked = parseBoolAttr(attr.Value.Bytes())
+					case "hidden":
+						dxf.Protection.Hidden = parseBoolAttr(attr.Value.Bytes())
+					}
+				}
+				err = decoder.Skip()
+			default:
+				err = decoder.Skip()
+			}
+			if err != nil {
+				return dxf, err
+			}
+		case *xml.EndElement:
+			return dxf, nil
 		}
 	}
+}
 
+// resolveColors sets the resolved values of the style colors.
+func (s *styleSheet) resolveColors(r *colorResolver) {
+	for i := range s.fonts {
+		r.resolve(&s.fonts[i].Color)
+	}
+	for i := range s.fills {
+		resolveFillColors(r, &s.fills[i])
+	}
+	for i := range s.borders {
+		resolveBorderColors(r, &s.borders[i])
+	}
+	for i := range s.dxfs {
+		d := &s.dxfs[i]
+		if d.Font != nil {
+			r.resolve(&d.Font.Color)
+		}
+		if d.Fill != nil {
+			resolveFillColors(r, d.Fill)
+		}
+		if d.Border != nil {
+			resolveBorderColors(r, d.Border)
+		}
+	}
+}
+
+func resolveFillColors(r *colorResolver, f *Fill) {
+	r.resolve(&f.FgColor)
+	r.resolve(&f.BgColor)
+	if f.Gradient != nil {
+		for j := range f.Gradient.Stops {
+			r.resolve(&f.Gradient.Stops[j].Color)
+		}
+	}
+}
+
+func resolveBorderColors(r *colorResolver, b *Border) {
+	for _, edge := range []*BorderEdge{&b.Left, &b.Right, &b.Top, &b.Bottom, &b.Diagonal} {
+		r.resolve(&edge.Color)
+	}
+}
+
+// readXf reads an xf element including its end element.
+func readXf(decoder *xml.Decoder, attrs []xml.Attr) (cellXf, error) {
+	xf := cellXf{
+		protection: Protection{Locked: true},
+	}
+
+	var err error
+	for _, attr := range attrs {
+		switch attr.Name.Local {
+		case "numFmtId":
+			xf.numFmtID, err = strconv.Atoi(attr.Value.String())
+		case "fontId":
+			xf.fontID, err = strconv.Atoi(attr.Value.String())
+		case "fillId":
+			xf.fillID, err = strconv.Atoi(attr.Value.String())
+		case "borderId":
+			xf.borderID, err = strconv.Atoi(attr.Value.String())
+		case "xfId":
+			xf.xfID, err = strconv.Atoi(attr.Value.String())
+		case "quotePrefix":
+			xf.quotePrefix = parseBoolAttr(attr.Value.Bytes())
+		}
+		if err != nil {
+			return xf, fmt.Errorf("can not parse %s of xf: %w", attr.Name.Local, ErrIncorrectStyles)
+		}
+	}
+
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			return xf, err
+		}
+
+		switch token := t.(type) {
+		case *xml.StartElement:
+			switch token.Name.Local {
+			case "alignment":
+				xf.alignment, err = parseAlignment(token.Attr)
+				if err != nil {
+					return xf, err
+				}
+			case "protection":
+				for _, attr := range token.Attr {
+					switch attr.Name.Local {
+					case "locked":
+						xf.protection.Locked = parseBoolAttr(attr.Value.Bytes())
+					case "hidden":
+						xf.protection.Hidden = parseBoolAttr(attr.Value.Bytes())
+					}
+				}
+			}
+			if er := decoder.Skip(); er != nil {
+				return xf, er
+			}
+		case *xml.EndElement:
+			return xf, nil
+		}
+	}
+}
+
+func parseAlignment(attrs []xml.Attr) (Alignment, error) {
+	var (
+		a   Alignment
+		err error
+	)
+	for _, attr := range attrs {
+		switch attr.Name.Local {
+		case "horizontal":
+			a.Horizontal = attr.Value.String()
+		case "vertical":
+			a.Vertical = attr.Value.String()
+		case "wrapText":
+			a.WrapText = parseBoolAttr(attr.Value.Bytes())
+		case "shrinkToFit":
+			a.ShrinkToFit = parseBoolAttr(attr.Value.Bytes())
+		case "indent":
+			a.Indent, err = strconv.Atoi(attr.Value.String())
+		case "textRotation":
+			a.TextRotation, err = strconv.Atoi(attr.Value.String())
+		case "readingOrder":
+			a.ReadingOrder, err = strconv.Atoi(attr.Value.String())
+		}
+		if err != nil {
+			return a, fmt.Errorf("can not parse %s of alignment: %w", attr.Name.Local, ErrIncorrectStyles)
+		}
+	}
+	return a, nil
+}
+
+// readFill reads the children of a fill element including its end element.
+// Pattern is the pattern of a patternFill without patternType, which is none
+// for cell fills and solid for differential ones.
+func readFill(decoder *xml.Decoder, pattern string) (Fill, error) {
+	var (
+		fill Fill
+		stop *GradientStop
+	)
+	for depth := 0; ; {
+		t, err := decoder.Token()
+		if err != nil {
+			return fill, err
+		}
+
+		switch token := t.(type) {
+		case *xml.StartElement:
+			depth++
+			switch token.Name.Local {
+			case "patternFill":
+				fill.Pattern = pattern
+				for _, attr := range token.Attr {
+					if attr.Name.Local == "patternType" {
+						fill.Pattern = attr.Value.String()
+					}
+				}
+			case "gradientFill":
+				fill.Gradient = &GradientFill{Type: "linear"}
+				for _, attr := range token.Attr {
+					switch attr.Name.Local {
+					case "type":
+						fill.Gradient.Type = attr.Value.String()
+					case "degree":
+						fill.Gradient.Degree, _ = strconv.ParseFloat(attr.Value.String(), 64)
+					case "left":
+						fill.Gradient.Left, _ = strconv.ParseFloat(attr.Value.String(), 64)
+					case "right":
+						fill.Gradient.Right, _ = strconv.ParseFloat(attr.Value.String(), 64)
+					case "top":
+						fill.Gradient.Top, _ = strconv.ParseFloat(attr.Value.String(), 64)
+					case "bottom":
+						fill.Gradient.Bottom, _ = strconv.ParseFloat(attr.Value.String(), 64)
+					}
+				}
+			case "stop":
+				if fill.Gradient != nil {
+					fill.Gradient.Stops = append(fill.Gradient.Stops, GradientStop{})
+					stop = &fill.Gradient.Stops[len(fill.Gradient.Stops)-1]
+					for _, attr := range token.Attr {
+						if attr.Name.Local == "position" {
+							stop.Position, _ = strconv.ParseFloat(attr.Value.String(), 64)
+						}
+					}
+				}
+			case "fgColor":
+				fill.FgColor = parseColor(token.Attr)
+			case "bgColor":
+				fill.BgColor = parseColor(token.Attr)
+			case "color":
+				if stop != nil {
+					stop.Color = parseColor(token.Attr)
+				}
+			}
+		case *xml.EndElement:
+			if depth == 0 {
+				return fill, nil
+			}
+			depth--
+			if token.Name.Local == "stop" {
+				stop = nil
+			}
+		}
+	}
+}
+
+// readBorder reads the children of a border element including its end element.
+func readBorder(decoder *xml.Decoder, attrs []xml.Attr) (Border, error) {
+	var border Border
+	for _, attr := range attrs {
+		switch attr.Name.Local {
+		case "diagonalUp":
+			border.DiagonalUp = parseBoolAttr(attr.Value.Bytes())
+		case "diagonalDown":
+			border.DiagonalDown = parseBoolAttr(attr.Value.Bytes())
+		}
+	}
+
+	var edge *BorderEdge
+	for depth := 0; ; {
+		t, err := decoder.Token()
+		if err != nil {
+			return border, err
+		}
+
+		switch token := t.(type) {
+		case *xml.StartElement:
+			depth++
+			switch token.Name.Local {
+			case "left", "start":
+				edge = &border.Left
+			case "right", "end":
+				edge = &border.Right
+			case "top":
+				edge = &border.Top
+			case "bottom":
+				edge = &border.Bottom
+			case "diagonal":
+				edge = &border.Diagonal
+			case "color":
+				if edge != nil {
+					edge.Color = parseColor(token.Attr)
+				}
+				continue
+			default:
+				edge = nil
+				continue
+			}
+			for _, attr := range token.Attr {
+				if attr.Name.Local == "style" {
+					edge.Style = attr.Value.String()
+				}
+			}
+		case *xml.EndElement:
+			if depth == 0 {
+				return border, nil
+			}
+			depth--
+		}
+	}
+}
+
+func (s *styleSheet) getFormatCode(idx int) (int, string) {
+	if idx < 0 || idx >= len(s.cellXfs) {
+		return 0, "general"
+	}
+
+	id := s.cellXfs[idx].numFmtID
+	return id, s.formatCode(id)
+}
+
+// formatCode returns the code of a builtin or workbook number format.
+func (s *styleSheet) formatCode(id int) string {
+	code := ""
+	if id >= 0 && id <= builtinNumFormatsCount {
+		code = builtinNumFormats[id]
+	} else {
+		code = s.numFormats[id]
+	}
 	if code == "" {
 		code = "general"
 	}
+	return code
+}
 
+func (s *styleSheet) getFormat(idx int) *parsedNumFormat {
+	if s == nil {
+		return generalNumFormat
+	}
+
+	_, code := s.getFormatCode(idx)
 	format, ok := s.parsedFormats[code]
 	if !ok {
 		format = parseFullNumberFormatString(code)
@@ -120,3 +756,126 @@ func (s *styleSheet) getFormat(idx int) *parsedNumFormat {
 
 	return format
 }
+
+// getStyle resolves the cell format with the given index.
+func (s *styleSheet) getStyle(idx int) CellStyle {
+	if s == nil || idx < 0 || idx >= len(s.cellXfs) {
+		return CellStyle{
+			NumberFormat: "general",
+			Protection:   Protection{Locked: true},
+		}
+	}
+	return s.xfStyle(s.cellXfs[idx])
+}
+
+func (s *styleSheet) xfStyle(xf cellXf) CellStyle {
+	style := CellStyle{
+		NumFmtID:     xf.numFmtID,
+		NumberFormat: s.formatCode(xf.numFmtID),
+	}
+	if xf.fontID >= 0 && xf.fontID < len(s.fonts) {
+		style.Font = s.fonts[xf.fontID]
+	}
+	if xf.fillID >= 0 && xf.fillID < len(s.fills) {
+		style.Fill = copyFill(s.fills[xf.fillID])
+	}
+	if xf.borderID >= 0 && xf.borderID < len(s.borders) {
+		style.Border = s.borders[xf.borderID]
+	}
+	style.Alignment = xf.alignment
+	style.Protection = xf.protection
+	style.QuotePrefix = xf.quotePrefix
+	return style
+}
+
+func copyFill(fill Fill) Fill {
+	if g := fill.Gradient; g != nil {
+		c := *g
+		c.Stops = append([]GradientStop(nil), g.Stops...)
+		fill.Gradient = &c
+	}
+	return fill
+}
+
+func (s *styleSheet) getNamedStyle(ns namedStyle) NamedStyle {
+	style := NamedStyle{
+		Name:          ns.name,
+		BuiltinID:     ns.builtinID,
+		Hidden:        ns.hidden,
+		CustomBuiltin: ns.customBuiltin,
+		Style: CellStyle{
+			NumberFormat: "general",
+			Protection:   Protection{Locked: true},
+		},
+	}
+	if ns.xfID >= 0 && ns.xfID < len(s.cellStyleXfs) {
+		style.Style = s.xfStyle(s.cellStyleXfs[ns.xfID])
+	}
+	return style
+}
+
+// NamedStyles returns the cell styles of the workbook.
+func (x *Xlsx) NamedStyles() []NamedStyle {
+	if x.styles == nil {
+		return nil
+	}
+
+	result := make([]NamedStyle, 0, len(x.styles.namedStyles))
+	for _, ns := range x.styles.namedStyles {
+		result = append(result, x.styles.getNamedStyle(ns))
+	}
+	return result
+}
+
+// DifferentialFormat returns the dxf record with the given index, which is
+// the dxfId of conditional formatting rules and tables.
+func (x *Xlsx) DifferentialFormat(id int) (DifferentialFormat, bool) {
+	if x.styles == nil || id < 0 || id >= len(x.styles.dxfs) {
+		return DifferentialFormat{}, false
+	}
+
+	dxf := x.styles.dxfs[id]
+	if dxf.Font != nil {
+		f := *dxf.Font
+		dxf.Font = &f
+	}
+	if dxf.Fill != nil {
+		f := copyFill(*dxf.Fill)
+		dxf.Fill = &f
+	}
+	if dxf.Border != nil {
+		b := *dxf.Border
+		dxf.Border = &b
+	}
+	if dxf.Alignment != nil {
+		a := *dxf.Alignment
+		dxf.Alignment = &a
+	}
+	if dxf.Protection != nil {
+		p := *dxf.Protection
+		dxf.Protection = &p
+	}
+	return dxf, true
+}
+
+// CellStyle returns the format of the current cell.
+func (s *Sheet) CellStyle() CellStyle {
+	return s.styles.getStyle(s.cellFormat)
+}
+
+// CellNamedStyle returns the cell style the format of the current cell is
+// based on, false when the cell has no named style.
+func (s *Sheet) CellNamedStyle() (NamedStyle, bool) {
+	styles := s.styles
+	if styles == nil || s.cellFormat < 0 || s.cellFormat >= len(styles.cellXfs) {
+		return NamedStyle{}, false
+	}
+
+	xfID := styles.cellXfs[s.cellFormat].xfID
+	for _, ns := range styles.namedStyles {
+		if ns.xfID == xfID {
+			return styles.getNamedStyle(ns), true
+		}
+	}
+	return NamedStyle{}, false
+}
@@ -0,0 +1,169 @@
+package xlsx
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testFullStyles = `<?xml version="1.0" encoding="UTF-8"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="0.000"/></numFmts>` +
+	`<fonts count="2">` +
+	`<font><sz val="11"/><color theme="1"/><name val="Calibri"/><family val="2"/><scheme val="minor"/></font>` +
+	`<font><b/><i/><u val="double"/><sz val="14"/><color rgb="FFFF0000"/><name val="Arial"/></font>` +
+	`</fonts>` +
+	`<fills count="3">` +
+	`<fill><patternFill patternType="none"/></fill>` +
+	`<fill><patternFill patternType="solid"><fgColor rgb="FFFFFF00"/><bgColor indexed="64"/></patternFill></fill>` +
+	`<fill><gradientFill degree="90"><stop position="0"><color theme="0"/></stop><stop position="1"><color rgb="FF4472C4"/></stop></gradientFill></fill>` +
+	`</fills>` +
+	`<borders count="2">` +
+	`<border><left/><right/><top/><bottom/><diagonal/></border>` +
+	`<border diagonalUp="1"><left style="thin"><color indexed="64"/></left><right/><top style="medium"><color auto="1"/></top><bottom style="double"/><diagonal style="hair"/></border>` +
+	`</borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="3">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="164" fontId="1" fillId="1" borderId="1" xfId="0" applyFont="1" quotePrefix="1"><alignment horizontal="center" vertical="top" wrapText="1" indent="2" textRotation="45"/><protection locked="0" hidden="1"/></xf>` +
+	`<xf numFmtId="14" fontId="0" fillId="2" borderId="0" xfId="0"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
+
+func TestCellStyle(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/styles.xml": testFullStyles,
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData><row r="1">` +
+			`<c r="A1"><v>1</v></c>` +
+			`<c r="B1" s="1"><v>2</v></c>` +
+			`<c r="C1" s="2"><v>3</v></c>` +
+			`</row></sheetData>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	require.True(t, sheet.NextRow())
+
+	require.True(t, sheet.NextCell())
+	require.Equal(t, CellStyle{
+		NumFmtID: 0, NumberFormat: "general",
+		Font:       Font{Name: "Calibri", Size: 11, Color: Color{Kind: ColorTheme, Theme: 1}, Family: 2, Scheme: "minor"},
+		Fill:       Fill{Pattern: "none"},
+		Protection: Protection{Locked: true},
+	}, sheet.CellStyle())
+
+	require.True(t, sheet.NextCell())
+	require.Equal(t, CellStyle{
+		NumFmtID: 164, NumberFormat: "0.000",
+		Font: Font{Name: "Arial", Size: 14, Bold: true, Italic: true, Underline: "double", Color: Color{Kind: ColorRGB, RGB: "FFFF0000", ARGB: "FFFF0000"}},
+		Fill: Fill{Pattern: "solid", FgColor: Color{Kind: ColorRGB, RGB: "FFFFFF00", ARGB: "FFFFFF00"}, BgColor: Color{Kind: ColorIndexed, Indexed: 64, ARGB: "FF000000"}},
+		Border: Border{
+			Left:       BorderEdge{Style: "thin", Color: Color{Kind: ColorIndexed, Indexed: 64, ARGB: "FF000000"}},
+			Top:        BorderEdge{Style: "medium", Color: Color{Kind: ColorAuto}},
+			Bottom:     BorderEdge{Style: "double"},
+			Diagonal:   BorderEdge{Style: "hair"},
+			DiagonalUp: true,
+		},
+		Alignment:   Alignment{Horizontal: "center", Vertical: "top", WrapText: true, Indent: 2, TextRotation: 45},
+		Protection:  Protection{Hidden: true},
+		QuotePrefix: true,
+	}, sheet.CellStyle())
+
+	require.True(t, sheet.NextCell())
+	style := sheet.CellStyle()
+	require.Equal(t, 14, style.NumFmtID)
+	require.Equal(t, &GradientFill{
+		Type: "linear", Degree: 90,
+		Stops: []GradientStop{
+			{Position: 0, Color: Color{Kind: ColorTheme, Theme: 0}},
+			{Position: 1, Color: Color{Kind: ColorRGB, RGB: "FF4472C4", ARGB: "FF4472C4"}},
+		},
+	}, style.Fill.Gradient)
+}
+
+func TestNamedStylesAndDxfs(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/styles.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<numFmts count="1"><numFmt numFmtId="164" formatCode="0.0%"/></numFmts>` +
+			`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="15"/><color rgb="FF44546A"/><name val="Calibri"/></font></fonts>` +
+			`<fills count="1"><fill><patternFill patternType="none"/></fill></fills>` +
+			`<borders count="2"><border/><border><bottom style="thick"><color rgb="FF4472C4"/></bottom></border></borders>` +
+			`<cellStyleXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="1"/></cellStyleXfs>` +
+			`<cellXfs count="2"><xf fontId="0" fillId="0" borderId="0" xfId="0"/><xf fontId="1" fillId="0" borderId="1" xfId="1"/></cellXfs>` +
+			`<cellStyles count="2"><cellStyle name="Heading 1" xfId="1" builtinId="16"/><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+			`<dxfs count="2">` +
+			`<dxf><font><color rgb="FF9C0006"/></font><fill><patternFill><bgColor rgb="FFFFC7CE"/></patternFill></fill></dxf>` +
+			`<dxf><numFmt numFmtId="164" formatCode="0.0%"/><border><top style="thin"/></border></dxf>` +
+			`</dxfs>` +
+			`</styleSheet>`,
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData><row r="1"><c r="A1" s="1" t="inlineStr"><is><t>Title</t></is></c><c r="B1" s="7"><v>1</v></c></row></sheetData>`),
+	})
+
+	heading := CellStyle{
+		NumberFormat: "general",
+		Font:         Font{Name: "Calibri", Size: 15, Bold: true, Color: Color{Kind: ColorRGB, RGB: "FF44546A", ARGB: "FF44546A"}},
+		Fill:         Fill{Pattern: "none"},
+		Border:       Border{Bottom: BorderEdge{Style: "thick", Color: Color{Kind: ColorRGB, RGB: "FF4472C4", ARGB: "FF4472C4"}}},
+		Protection:   Protection{Locked: true},
+	}
+	require.Equal(t, []NamedStyle{
+		{Name: "Heading 1", BuiltinID: 16, Style: heading},
+		{Name: "Normal", BuiltinID: 0, Style: CellStyle{
+			NumberFormat: "general",
+			Font:         Font{Name: "Calibri", Size: 11},
+			Fill:         Fill{Pattern: "none"},
+			Protection:   Protection{Locked: true},
+		}},
+	}, xlsx.NamedStyles())
+
+	dxf, ok := xlsx.DifferentialFormat(0)
+	require.True(t, ok)
+	require.Equal(t, DifferentialFormat{
+		Font: &Font{Color: Color{Kind: ColorRGB, RGB: "FF9C0006", ARGB: "FF9C0006"}},
+		Fill: &Fill{Pattern: "solid", BgColor: Color{Kind: ColorRGB, RGB: "FFFFC7CE", ARGB: "FFFFC7CE"}},
+	}, dxf)
+
+	dxf, ok = xlsx.DifferentialFormat(1)
+	require.True(t, ok)
+	require.Equal(t, DifferentialFormat{
+		NumFmtID: 164, NumberFormat: "0.0%",
+		Border: &Border{Top: BorderEdge{Style: "thin"}},
+	}, dxf)
+
+	_, ok = xlsx.DifferentialFormat(2)
+	require.False(t, ok)
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextCell())
+	style, ok := sheet.CellNamedStyle()
+	require.True(t, ok)
+	require.Equal(t, "Heading 1", style.Name)
+	require.Equal(t, heading, style.Style)
+
+	require.True(t, sheet.NextCell())
+	_, ok = sheet.CellNamedStyle()
+	require.False(t, ok)
+}
+
+func TestNamedStyleIncorrect(t *testing.T) {
+	_, err := readStyleSheet(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<cellStyles count="1"><cellStyle name="Normal" xfId="x" builtinId="0"/></cellStyles></styleSheet>`))
+	require.ErrorIs(t, err, ErrIncorrectStyles)
+}
+
+func TestCellXfIncorrect(t *testing.T) {
+	_, err := readStyleSheet(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<cellXfs count="1"><xf numFmtId="0"><alignment indent="x"/></xf></cellXfs></styleSheet>`))
+	require.ErrorIs(t, err, ErrIncorrectStyles)
+}
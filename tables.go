@@ -0,0 +1,207 @@
+package xlsx
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Table is an Excel table. Ref covers the header, data and totals rows.
+// The dxf ids are the differential formats of the table areas or -1.
+type Table struct {
+	ID             int
+	Name           string
+	DisplayName    string
+	Sheet          string
+	Ref            Range
+	HeaderRowCount int
+	TotalsRowCount int
+	HeaderRowDxfID int
+	DataDxfID      int
+	TotalsRowDxfID int
+	Columns        []TableColumn
+}
+
+// TableColumn is a column of a table. CalculatedFormula is the formula of
+// a calculated column, TotalsRowFunction is the function of the totals row
+// cell, like sum or average, and custom for TotalsRowFormula.
+type TableColumn struct {
+	ID                int
+	Name              string
+	CalculatedFormula string
+	TotalsRowFunction string
+	TotalsRowFormula  string
+	TotalsRowLabel    string
+}
+
+// DataRange returns the range of the table without header and totals rows.
+func (t Table) DataRange() Range {
+	r := t.Ref
+	r.FirstRow += t.HeaderRowCount
+	r.LastRow -= t.TotalsRowCount
+	return r
+}
+
+type tableXML struct {
+	XMLName        xml.Name `xml:"table"`
+	ID             int      `xml:"id,attr"`
+	Name           string   `xml:"name,attr"`
+	DisplayName    string   `xml:"displayName,attr"`
+	Ref            string   `xml:"ref,attr"`
+	HeaderRowCount *int     `xml:"headerRowCount,attr"`
+	TotalsRowCount int      `xml:"totalsRowCount,attr"`
+	HeaderRowDxfID *int     `xml:"headerRowDxfId,attr"`
+	DataDxfID      *int     `xml:"dataDxfId,attr"`
+	TotalsRowDxfID *int     `xml:"totalsRowDxfId,attr"`
+	Columns        []struct {
+		ID                int    `xml:"id,attr"`
+		Name              string `xml:"name,attr"`
+		TotalsRowFunction string `xml:"totalsRowFunction,attr"`
+		TotalsRowLabel    string `xml:"totalsRowLabel,attr"`
+		CalculatedFormula string `xml:"calculatedColumnFormula"`
+		TotalsRowFormula  string `xml:"totalsRowFormula"`
+	} `xml:"tableColumns>tableColumn"`
+}
+
+func readTable(reader io.Reader) (Table, error) {
+	decoder := xml.NewDecoder(reader)
+	data := &tableXML{}
+	err := decoder.Decode(data)
+	if err != nil {
+		return Table{}, err
+	}
+
+	r, err := ParseRange(data.Ref)
+	if err != nil {
+		return Table{}, err
+	}
+
+	t := Table{
+		ID:             data.ID,
+		Name:           data.Name,
+		DisplayName:    data.DisplayName,
+		Ref:            r,
+		HeaderRowCount: 1,
+		TotalsRowCount: data.TotalsRowCount,
+		HeaderRowDxfID: optionalID(data.HeaderRowDxfID),
+		DataDxfID:      optionalID(data.DataDxfID),
+		TotalsRowDxfID: optionalID(data.TotalsRowDxfID),
+		Columns:        make([]TableColumn, 0, len(data.Columns)),
+	}
+	if data.HeaderRowCount != nil {
+		t.HeaderRowCount = *data.HeaderRowCount
+	}
+	for _, c := range data.Columns {
+		t.Columns = append(t.Columns, TableColumn{
+			ID:                c.ID,
+			Name:              c.Name,
+			CalculatedFormula: c.CalculatedFormula,
+			TotalsRowFunction: c.TotalsRowFunction,
+			TotalsRowFormula:  c.TotalsRowFormula,
+			TotalsRowLabel:    c.TotalsRowLabel,
+		})
+	}
+	return t, nil
+}
+
+func optionalID(id *int) int {
+	if id == nil {
+		return -1
+	}
+	return *id
+}
+
+func (x *Xlsx) loadTables() ([]Table, error) {
+	x.tablesOnce.Do(func() {
+		for _, info := range x.sheets {
+			if info.Kind != SheetKindWorksheet {
+				continue
+			}
+
+			rels, err := x.partRels(info.Path)
+			if err != nil {
+				x.tablesErr = err
+				return
+			}
+			for _, rel := range sortedRels(rels) {
+				if rel.Type != relTypeTable {
+					continue
+				}
+				zipFile, ok := x.files[resolveTarget(info.Path, rel.Target)]
+				if !ok {
+					continue
+				}
+
+				reader, err := zipFile.Open()
+				if err != nil {
+					x.tablesErr = err
+					return
+				}
+				t, err := readTable(reader)
+				_ = reader.Close()
+				if err != nil {
+					x.tablesErr = err
+					return
+				}
+				t.Sheet = info.Name
+				x.tables = append(x.tables, t)
+			}
+		}
+	})
+	return x.tables, x.tablesErr
+}
+
+// Tables returns the tables of all worksheets.
+func (x *Xlsx) Tables() ([]Table, error) {
+	tables, err := x.loadTables()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Table, len(tables))
+	for i, t := range tables {
+		t.Columns = append([]TableColumn(nil), t.Columns...)
+		result[i] = t
+	}
+	return result, nil
+}
+
+// OpenTable opens the worksheet of the table with the given name restricted
+// to the table data rows, see WithRange. The header of the sheet is set to the
+// table column names, so HeaderValue and DecodeRow can be used right away.
+func (x *Xlsx) OpenTable(name string, opts ...SheetOption) (*Sheet, error) {
+	tables, err := x.loadTables()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tables {
+		if !strings.EqualFold(t.Name, name) && !strings.EqualFold(t.DisplayName, name) {
+			continue
+		}
+
+		sheet, er := x.OpenSheetByName(t.Sheet, append(opts[:len(opts):len(opts)], WithRange(t.DataRange()))...)
+		if er != nil {
+			return nil, er
+		}
+
+		header := &sheetHeader{
+			opts:    HeaderOptions{IgnoreCase: true, AllowDuplicates: true},
+			names:   make([]string, t.Ref.FirstCol, t.Ref.FirstCol+len(t.Columns)),
+			columns: make(map[string]int, len(t.Columns)),
+		}
+		for _, c := range t.Columns {
+			header.names = append(header.names, c.Name)
+		}
+		err = header.index()
+		if err != nil {
+			_ = sheet.Close()
+			return nil, err
+		}
+		sheet.setHeader(header)
+		return sheet, nil
+	}
+
+	return nil, fmt.Errorf("can not find table %s: %w", name, ErrTableNotFound)
+}
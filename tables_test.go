@@ -0,0 +1,95 @@
+package xlsx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTables(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="A1" t="inlineStr"><is><t>Orders</t></is></c></row>` +
+			`<row r="2"><c r="B2" t="inlineStr"><is><t>Item</t></is></c><c r="C2" t="inlineStr"><is><t>Qty</t></is></c><c r="D2" t="inlineStr"><is><t>Amount</t></is></c></row>` +
+			`<row r="3"><c r="A3"><v>0</v></c><c r="B3" t="inlineStr"><is><t>pen</t></is></c><c r="C3"><v>2</v></c><c r="D3"><f>Orders[[#This Row],[Qty]]*2</f><v>4</v></c></row>` +
+			`<row r="4"><c r="B4" t="inlineStr"><is><t>pad</t></is></c><c r="C4"><v>3</v></c><c r="D4"><f>Orders[[#This Row],[Qty]]*2</f><v>6</v></c></row>` +
+			`<row r="5"><c r="B5" t="inlineStr"><is><t>Total</t></is></c><c r="D5"><f>SUBTOTAL(109,Orders[Amount])</f><v>10</v></c></row>` +
+			`<row r="6"><c r="B6" t="inlineStr"><is><t>after</t></is></c></row>` +
+			`</sheetData><tableParts count="1"><tablePart r:id="rId1"/></tableParts>`),
+		"xl/worksheets/_rels/sheet1.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/table" Target="../tables/table1.xml"/></Relationships>`,
+		"xl/tables/table1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<table xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" id="1" name="Table1" displayName="Orders" ref="B2:D5" totalsRowCount="1" dataDxfId="2">` +
+			`<autoFilter ref="B2:D4"/><tableColumns count="3">` +
+			`<tableColumn id="1" name="Item" totalsRowLabel="Total"/>` +
+			`<tableColumn id="2" name="Qty"/>` +
+			`<tableColumn id="3" name="Amount" totalsRowFunction="sum"><calculatedColumnFormula>Orders[[#This Row],[Qty]]*2</calculatedColumnFormula></tableColumn>` +
+			`</tableColumns><tableStyleInfo name="TableStyleMedium2" showRowStripes="1"/></table>`,
+	})
+
+	tables, err := xlsx.Tables()
+	require.NoError(t, err)
+	require.Equal(t, []Table{
+		{
+			ID: 1, Name: "Table1", DisplayName: "Orders", Sheet: "Sheet1",
+			Ref:            Range{FirstRow: 1, FirstCol: 1, LastRow: 4, LastCol: 3},
+			HeaderRowCount: 1, TotalsRowCount: 1,
+			HeaderRowDxfID: -1, DataDxfID: 2, TotalsRowDxfID: -1,
+			Columns: []TableColumn{
+				{ID: 1, Name: "Item", TotalsRowLabel: "Total"},
+				{ID: 2, Name: "Qty"},
+				{ID: 3, Name: "Amount", CalculatedFormula: "Orders[[#This Row],[Qty]]*2", TotalsRowFunction: "sum"},
+			},
+		},
+	}, tables)
+	require.Equal(t, Range{FirstRow: 2, FirstCol: 1, LastRow: 3, LastCol: 3}, tables[0].DataRange())
+
+	sheet, err := xlsx.OpenTable("orders")
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	require.Equal(t, []string{"", "Item", "Qty", "Amount"}, sheet.Header())
+
+	type order struct {
+		Item   string  `xlsx:"header=Item"`
+		Qty    int     `xlsx:"header=Qty"`
+		Amount float64 `xlsx:"header=amount"`
+	}
+	orders, err := DecodeAll[order](sheet)
+	require.NoError(t, err)
+	require.Equal(t, []order{
+		{Item: "pen", Qty: 2, Amount: 4},
+		{Item: "pad", Qty: 3, Amount: 6},
+	}, orders)
+
+	_, err = xlsx.OpenTable("Missing")
+	require.ErrorIs(t, err, ErrTableNotFound)
+}
+
+func TestTablesOrder(t *testing.T) {
+	table := func(id, name string) string {
+		return `<?xml version="1.0" encoding="UTF-8"?>
+<table xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" id="` + id + `" name="` + name + `" displayName="` + name + `" ref="A1:A2">` +
+			`<tableColumns count="1"><tableColumn id="1" name="A"/></tableColumns></table>`
+	}
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData/>`),
+		"xl/worksheets/_rels/sheet1.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId10" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/table" Target="../tables/table3.xml"/>` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/table" Target="../tables/table1.xml"/>` +
+			`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/table" Target="../tables/table2.xml"/>` +
+			`</Relationships>`,
+		"xl/tables/table1.xml": table("1", "First"),
+		"xl/tables/table2.xml": table("2", "Second"),
+		"xl/tables/table3.xml": table("3", "Third"),
+	})
+
+	tables, err := xlsx.Tables()
+	require.NoError(t, err)
+	var names []string
+	for _, table := range tables {
+		names = append(names, table.Name)
+	}
+	require.Equal(t, []string{"First", "Second", "Third"}, names)
+}
@@ -0,0 +1,279 @@
+package xlsx
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anfilat/xlsx-sax/internal/xml"
+)
+
+// Mention is a person mentioned in the text of a threaded comment.
+// Start and Length locate the mention in the text.
+type Mention struct {
+	PersonID  string
+	Person    string
+	MentionID string
+	Start     int
+	Length    int
+}
+
+// ThreadedComment is a single comment of a thread. Author is the display
+// name of the person, Done is set on the first comment of a resolved thread.
+type ThreadedComment struct {
+	ID       string
+	ParentID string
+	PersonID string
+	Author   string
+	Time     time.Time
+	Done     bool
+	Text     string
+	Mentions []Mention
+}
+
+// Thread is a threaded comment on a cell with its replies in order.
+type Thread struct {
+	Ref     string
+	Row     int
+	Col     int
+	Comment ThreadedComment
+	Replies []ThreadedComment
+}
+
+type threadIndex struct {
+	threads []Thread
+	cells   map[int]int
+}
+
+func readThreadedComments(reader io.Reader) ([]Thread, error) {
+	decoder := xml.NewDecoder(reader, []xml.TagAttrs{
+		{
+			Name: "threadedComment",
+			Attr: []xml.TagAttr{
+				{Name: "ref"},
+				{Name: "dT"},
+				{Name: "personId"},
+				{Name: "id"},
+				{Name: "parentId"},
+				{Name: "done"},
+			},
+		},
+		{
+			Name: "mention",
+			Attr: []xml.TagAttr{
+				{Name: "mentionpersonId"},
+				{Name: "mentionId"},
+				{Name: "startIndex"},
+				{Name: "length"},
+			},
+		},
+	})
+
+	var (
+		result  []Thread
+		roots   = make(map[string]int)
+		comment *ThreadedComment
+		ref     string
+		text    strings.Builder
+		isText  bool
+	)
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		switch token := t.(type) {
+		case *xml.StartElement:
+			switch token.Name.Local {
+			case "threadedComment":
+				comment = &ThreadedComment{}
+				ref = ""
+				text.Reset()
+				for _, a := range token.Attr {
+					switch a.Name.Local {
+					case "ref":
+						ref = a.Value.String()
+					case "dT":
+						if a.Value.Len() > 0 {
+							comment.Time, err = parseISOTime(a.Value.String())
+							if err != nil {
+								return nil, ErrIncorrectComments
+							}
+						}
+					case "personId":
+						comment.PersonID = a.Value.String()
+					case "id":
+						comment.ID = a.Value.String()
+					case "parentId":
+						comment.ParentID = a.Value.String()
+					case "done":
+						comment.Done = parseBoolAttr(a.Value.Bytes())
+					}
+				}
+			case "text":
+				isText = comment != nil
+			case "mention":
+				if comment == nil {
+					break
+				}
+				var m Mention
+				for _, a := range token.Attr {
+					switch a.Name.Local {
+					case "mentionpersonId":
+						m.PersonID = a.Value.String()
+					case "mentionId":
+						m.MentionID = a.Value.String()
+					case "startIndex":
+						m.Start, err = strconv.Atoi(a.Value.String())
+					case "length":
+						m.Length, err = strconv.Atoi(a.Value.String())
+					}
+					if err != nil {
+						return nil, ErrIncorrectComments
+					}
+				}
+				comment.Mentions = append(comment.Mentions, m)
+			case "extLst":
+				if er := decoder.Skip(); er != nil {
+					return nil, er
+				}
+			}
+		case *xml.EndElement:
+			switch token.Name.Local {
+			case "text":
+				isText = false
+			case "threadedComment":
+				if comment == nil {
+					break
+				}
+				comment.Text = text.String()
+
+				if i, ok := roots[comment.ParentID]; ok && comment.ParentID != "" {
+					result[i].Replies = append(result[i].Replies, *comment)
+				} else {
+					col, row, ok := parseCellRef([]byte(ref))
+					if !ok {
+						return nil, ErrIncorrectComments
+					}
+					result = append(result, Thread{
+						Ref:     ref,
+						Row:     row,
+						Col:     col,
+						Comment: *comment,
+					})
+					if comment.ID != "" {
+						roots[comment.ID] = len(result) - 1
+					}
+				}
+				comment = nil
+			}
+		case *xml.CharData:
+			if isText {
+				text.Write(token.Value)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Sheet) loadThreads() (*threadIndex, error) {
+	if s.threads != nil {
+		return s.threads, nil
+	}
+
+	index := &threadIndex{
+		cells: make(map[int]int),
+	}
+
+	rels, err := s.x.partRels(s.file.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, rel := range sortedRels(rels) {
+		if rel.Type != relTypeThreadedComment {
+			continue
+		}
+
+		zipFile, ok := s.x.files[resolveTarget(s.file.Name, rel.Target)]
+		if !ok {
+			continue
+		}
+
+		reader, er := zipFile.Open()
+		if er != nil {
+			return nil, er
+		}
+		threads, er := readThreadedComments(reader)
+		_ = reader.Close()
+		if er != nil {
+			return nil, er
+		}
+		index.threads = append(index.threads, threads...)
+	}
+
+	if len(index.threads) > 0 {
+		persons, er := s.x.loadPersons()
+		if er != nil {
+			return nil, er
+		}
+		names := make(map[string]string, len(persons))
+		for _, p := range persons {
+			names[p.ID] = p.DisplayName
+		}
+
+		for i := range index.threads {
+			th := &index.threads[i]
+			resolvePersons(&th.Comment, names)
+			for j := range th.Replies {
+				resolvePersons(&th.Replies[j], names)
+			}
+		}
+	}
+
+	for i, th := range index.threads {
+		index.cells[th.Row*maxColumns+th.Col] = i
+	}
+
+	s.threads = index
+	return index, nil
+}
+
+func resolvePersons(c *ThreadedComment, names map[string]string) {
+	c.Author = names[c.PersonID]
+	for i := range c.Mentions {
+		c.Mentions[i].Person = names[c.Mentions[i].PersonID]
+	}
+}
+
+// Threads returns the threaded comments of the sheet.
+func (s *Sheet) Threads() ([]Thread, error) {
+	index, err := s.loadThreads()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Thread, len(index.threads))
+	copy(result, index.threads)
+	return result, nil
+}
+
+// CellThread returns the threaded comment of the current cell or nil.
+func (s *Sheet) CellThread() (*Thread, error) {
+	index, err := s.loadThreads()
+	if err != nil {
+		return nil, err
+	}
+
+	i, ok := index.cells[s.Row*maxColumns+s.Col]
+	if !ok {
+		return nil, nil
+	}
+	th := index.threads[i]
+	return &th, nil
+}
@@ -0,0 +1,74 @@
+package xlsx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThreadedComments(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/><Relationship Id="rId5" Type="http://schemas.microsoft.com/office/2017/10/relationships/person" Target="persons/person.xml"/></Relationships>`,
+		"xl/persons/person.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<personList xmlns="http://schemas.microsoft.com/office/spreadsheetml/2018/threadedcomments"><person displayName="Ann" id="{P1}" userId="ann@example.com" providerId="AD"/><person displayName="Bob" id="{P2}" userId="Bob" providerId="None"/></personList>`,
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="A1"><v>1</v></c><c r="B1"><v>2</v></c></row>` +
+			`</sheetData>`),
+		"xl/worksheets/_rels/sheet1.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId2" Type="http://schemas.microsoft.com/office/2017/10/relationships/threadedComment" Target="../threadedComments/threadedComment1.xml"/></Relationships>`,
+		"xl/threadedComments/threadedComment1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<ThreadedComments xmlns="http://schemas.microsoft.com/office/spreadsheetml/2018/threadedcomments">` +
+			`<threadedComment ref="B1" dT="2023-05-01T10:20:30.50" personId="{P1}" id="{C1}" done="1"><text>Hi @Bob</text><mentions><mention mentionpersonId="{P2}" mentionId="{M1}" startIndex="3" length="4"/></mentions></threadedComment>` +
+			`<threadedComment ref="B1" dT="2023-05-02T08:00:00" personId="{P2}" id="{C2}" parentId="{C1}"><text>Done</text></threadedComment>` +
+			`</ThreadedComments>`,
+	})
+
+	persons, err := xlsx.Persons()
+	require.NoError(t, err)
+	require.Equal(t, []Person{
+		{ID: "{P1}", DisplayName: "Ann", UserID: "ann@example.com", ProviderID: "AD"},
+		{ID: "{P2}", DisplayName: "Bob", UserID: "Bob", ProviderID: "None"},
+	}, persons)
+
+	sheet, err := xlsx.OpenSheetByOrder(0)
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	threads, err := sheet.Threads()
+	require.NoError(t, err)
+	require.Equal(t, []Thread{
+		{
+			Ref: "B1", Row: 0, Col: 1,
+			Comment: ThreadedComment{
+				ID: "{C1}", PersonID: "{P1}", Author: "Ann",
+				Time: time.Date(2023, 5, 1, 10, 20, 30, 500000000, time.UTC),
+				Done: true, Text: "Hi @Bob",
+				Mentions: []Mention{
+					{PersonID: "{P2}", Person: "Bob", MentionID: "{M1}", Start: 3, Length: 4},
+				},
+			},
+			Replies: []ThreadedComment{
+				{
+					ID: "{C2}", ParentID: "{C1}", PersonID: "{P2}", Author: "Bob",
+					Time: time.Date(2023, 5, 2, 8, 0, 0, 0, time.UTC),
+					Text: "Done",
+				},
+			},
+		},
+	}, threads)
+
+	require.True(t, sheet.NextRow())
+	require.True(t, sheet.NextCell())
+	th, err := sheet.CellThread()
+	require.NoError(t, err)
+	require.Nil(t, th)
+
+	require.True(t, sheet.NextCell())
+	th, err = sheet.CellThread()
+	require.NoError(t, err)
+	require.NotNil(t, th)
+	require.Equal(t, "{C1}", th.Comment.ID)
+	require.Len(t, th.Replies, 1)
+}
@@ -0,0 +1,166 @@
+package xlsx
+
+import (
+	"errors"
+	"io"
+)
+
+const filterDatabaseName = "_xlnm._FilterDatabase"
+
+type visibleState struct {
+	hiddenCols []Column
+
+	filterRange Range
+	filters     []columnFilter
+	filterCols  []int
+	texts       []string
+	values      []string
+
+	// cells holds the current row when it was read ahead to check the filter.
+	cells    []savedCell
+	pos      int
+	buffered bool
+}
+
+func (s *Sheet) initVisible() error {
+	v := &visibleState{}
+	for _, c := range s.columns {
+		if c.Hidden {
+			v.hiddenCols = append(v.hiddenCols, c)
+		}
+	}
+
+	tail, err := s.loadTail()
+	if err != nil {
+		return err
+	}
+
+	if f := tail.autoFilter; f != nil && len(f.columns) > 0 {
+		r, ok := f.ref, f.hasRef
+		if !ok {
+			r, ok = s.filterDatabase()
+		}
+		if ok {
+			v.filterRange = r
+			v.filters = f.columns
+			for _, c := range f.columns {
+				v.filterCols = append(v.filterCols, r.FirstCol+c.colID)
+			}
+			v.texts = make([]string, len(f.columns))
+			v.values = make([]string, len(f.columns))
+		}
+	}
+
+	s.visible = v
+	return nil
+}
+
+// filterDatabase returns the range of the hidden name Excel keeps for the
+// autoFilter of the sheet.
+func (s *Sheet) filterDatabase() (Range, bool) {
+	value, ok := s.x.localDefinedName(filterDatabaseName, s.name)
+	if !ok {
+		return Range{}, false
+	}
+
+	_, cell := splitLocation(value)
+	if cell == "" {
+		return Range{}, false
+	}
+	r, err := ParseRange(cell)
+	if err != nil {
+		return Range{}, false
+	}
+	return r, true
+}
+
+// visibleRow reports whether the current row is visible. Cells of rows inside
+// the filter range are read ahead to check the filter criteria.
+func (s *Sheet) visibleRow() bool {
+	v := s.visible
+	v.buffered = false
+
+	if s.rowInfo.Hidden {
+		for s.readCell() {
+		}
+		return false
+	}
+
+	if len(v.filters) == 0 || s.Row <= v.filterRange.FirstRow || s.Row > v.filterRange.LastRow {
+		return true
+	}
+
+	clear(v.texts)
+	clear(v.values)
+	v.cells = v.cells[:0]
+	for s.readCell() {
+		if len(v.cells) < cap(v.cells) {
+			v.cells = v.cells[:len(v.cells)+1]
+		} else {
+			v.cells = append(v.cells, savedCell{})
+		}
+		s.saveCell(&v.cells[len(v.cells)-1])
+
+		for i, col := range v.filterCols {
+			if col != s.Col {
+				continue
+			}
+			text, err := s.CellFormatValue()
+			if err != nil {
+				s.err = err
+				return false
+			}
+			value, err := s.CellValue()
+			if err != nil {
+				s.err = err
+				return false
+			}
+			v.texts[i] = text
+			v.values[i] = value
+		}
+	}
+	if s.err != nil && !errors.Is(s.err, io.EOF) {
+		return false
+	}
+
+	for i := range v.filters {
+		if !v.filters[i].match(v.texts[i], v.values[i]) {
+			return false
+		}
+	}
+
+	v.pos = 0
+	v.buffered = true
+	return true
+}
+
+func (s *Sheet) nextVisibleCell() bool {
+	v := s.visible
+	for {
+		if v.buffered {
+			if v.pos >= len(v.cells) {
+				return false
+			}
+			s.restoreCell(&v.cells[v.pos])
+			v.pos++
+			if s.merge != nil {
+				s.merge.current = s.merge.find(s.Row, s.Col)
+			}
+		} else if !s.readCell() {
+			return false
+		}
+
+		if !v.hiddenColumn(s.Col) {
+			return true
+		}
+	}
+}
+
+func (v *visibleState) hiddenColumn(col int) bool {
+	for _, c := range v.hiddenCols {
+		if col >= c.FirstCol && col <= c.LastCol {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+package xlsx
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readVisible(t *testing.T, sheet *Sheet) [][]string {
+	t.Helper()
+
+	var result [][]string
+	for sheet.NextRow() {
+		var row []string
+		for sheet.NextCell() {
+			v, err := sheet.CellValue()
+			require.NoError(t, err)
+			row = append(row, cellName(sheet.Col, sheet.Row)+"="+v)
+		}
+		result = append(result, row)
+	}
+	return result
+}
+
+func TestVisibleOnly(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<cols><col min="2" max="2" width="0" hidden="1"/></cols><sheetData>` +
+			`<row r="1"><c r="A1" t="inlineStr"><is><t>Kind</t></is></c><c r="B1"><v>0</v></c><c r="C1" t="inlineStr"><is><t>Qty</t></is></c></row>` +
+			`<row r="2"><c r="A2" t="inlineStr"><is><t>a</t></is></c><c r="B2"><v>1</v></c><c r="C2"><v>10</v></c></row>` +
+			`<row r="3" hidden="1"><c r="A3" t="inlineStr"><is><t>a</t></is></c><c r="C3"><v>20</v></c></row>` +
+			`<row r="4"><c r="A4" t="inlineStr"><is><t>c</t></is></c><c r="C4"><v>30</v></c></row>` +
+			`<row r="5"><c r="A5" t="inlineStr"><is><t>B</t></is></c><c r="C5"><v>3</v></c></row>` +
+			`<row r="6"><c r="A6" t="inlineStr"><is><t>b</t></is></c><c r="C6"><v>7</v></c></row>` +
+			`<row r="8"><c r="A8" t="inlineStr"><is><t>total</t></is></c><c r="C8"><v>1</v></c></row>` +
+			`</sheetData>` +
+			`<autoFilter ref="A1:C6"><filterColumn colId="0"><filters><filter val="a"/><filter val="b"/></filters></filterColumn>` +
+			`<filterColumn colId="2"><customFilters><customFilter operator="greaterThan" val="5"/></customFilters></filterColumn></autoFilter>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0, WithVisibleOnly())
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	require.Equal(t, [][]string{
+		{"A1=Kind", "C1=Qty"},
+		{"A2=a", "C2=10"},
+		{"A6=b", "C6=7"},
+		{"A8=total", "C8=1"},
+	}, readVisible(t, sheet))
+	require.ErrorIs(t, sheet.Err(), io.EOF)
+}
+
+func TestVisibleOnlyFilterDatabase(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets>` +
+			`<definedNames><definedName name="_xlnm._FilterDatabase" localSheetId="0" hidden="1">Sheet1!$A$1:$A$3</definedName></definedNames></workbook>`,
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="A1" t="inlineStr"><is><t>Name</t></is></c></row>` +
+			`<row r="2"><c r="A2" t="inlineStr"><is><t>apple</t></is></c></row>` +
+			`<row r="3"><c r="A3" t="inlineStr"><is><t>pear</t></is></c></row>` +
+			`</sheetData>` +
+			`<autoFilter><filterColumn colId="0"><customFilters><customFilter val="a*"/></customFilters></filterColumn></autoFilter>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0, WithVisibleOnly())
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	require.Equal(t, [][]string{
+		{"A1=Name"},
+		{"A2=apple"},
+	}, readVisible(t, sheet))
+}
+
+func TestVisibleOnlyDateGroups(t *testing.T) {
+	xlsx := newTestXlsx(t, map[string]string{
+		"xl/worksheets/sheet1.xml": testWorksheet(`<sheetData>` +
+			`<row r="1"><c r="A1" t="inlineStr"><is><t>When</t></is></c></row>` +
+			`<row r="2"><c r="A2" t="inlineStr"><is><t>soon</t></is></c></row>` +
+			`<row r="3"><c r="A3" t="inlineStr"><is><t>later</t></is></c></row>` +
+			`<row r="4"><c r="A4"><v>45352</v></c></row>` +
+			`<row r="5"><c r="A5" t="d"><v>2024-03-01</v></c></row>` +
+			`</sheetData>` +
+			`<autoFilter ref="A1:A5"><filterColumn colId="0"><filters><filter val="soon"/>` +
+			`<dateGroupItem year="2024" month="3" dateTimeGrouping="month"/></filters></filterColumn></autoFilter>`),
+	})
+
+	sheet, err := xlsx.OpenSheetByOrder(0, WithVisibleOnly())
+	require.NoError(t, err)
+	defer sheet.Close()
+
+	require.Equal(t, [][]string{
+		{"A1=When"},
+		{"A2=soon"},
+		{"A4=45352"},
+		{"A5=2024-03-01"},
+	}, readVisible(t, sheet))
+}
+
+func TestMatchWildcard(t *testing.T) {
+	tests := []struct {
+		pattern string
+		text    string
+		want    bool
+	}{
+		{"a*", "apple", true},
+		{"a*", "pear", false},
+		{"*e*", "pear", true},
+		{"p?ar", "pear", true},
+		{"p?ar", "par", false},
+		{"~*", "*", true},
+		{"~*", "a", false},
+		{"*", "", true},
+		{"a/b*", "a/bc", true},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, matchWildcard(tt.pattern, tt.text), tt.pattern+" "+tt.text)
+	}
+}
@@ -22,7 +22,14 @@ type workbook struct {
 	} `xml:"workbookPr"`
 	Sheets []struct {
 		Name    string `xml:"name,attr"`
-		SheetId string `xml:"sheetId,attr"`
-		ID      string `xml:"id,attr"`
+		SheetID string `xml:"sheetId,attr"`
+		State   string `xml:"state,attr"`
+		RID     string `xml:"id,attr"`
 	} `xml:"sheets>sheet"`
+	DefinedNames []struct {
+		Name         string `xml:"name,attr"`
+		LocalSheetID *int   `xml:"localSheetId,attr"`
+		Hidden       bool   `xml:"hidden,attr"`
+		Value        string `xml:",chardata"`
+	} `xml:"definedNames>definedName"`
 }
@@ -4,17 +4,39 @@ import (
 	"archive/zip"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
+	"sync"
 )
 
 type Xlsx struct {
 	zip           *zip.Reader
+	files         map[string]*zip.File
 	date1904      bool
 	sheetFile     []*zip.File
 	sheetNames    []string
 	sheetNameFile map[string]*zip.File
 	sharedStrings sharedStrings
+	sharedFile    *zip.File
 	styles        *styleSheet
+	colors        *colorResolver
+	workbookRels  []relationship
+	sheets        []SheetInfo
+	definedNames  []DefinedName
+
+	tabColorsOnce sync.Once
+
+	tablesOnce sync.Once
+	tables     []Table
+	tablesErr  error
+
+	personsOnce sync.Once
+	persons     []Person
+	personsErr  error
+
+	richStringsOnce sync.Once
+	richStrings     map[int][]RichTextRun
+	richStringsErr  error
 }
 
 func New(reader io.ReaderAt, size int64) (*Xlsx, error) {
@@ -40,13 +62,14 @@ func (x *Xlsx) load() error {
 	for _, file := range x.zip.File {
 		files[file.Name] = file
 	}
+	x.files = files
 
 	workbookRelsFile, ok := files["xl/_rels/workbook.xml.rels"]
 	if !ok {
 		return ErrWorkbookRelsNotExist
 	}
 
-	sheets, err := x.getWorkbookRels(workbookRelsFile)
+	rels, err := x.getWorkbookRels(workbookRelsFile)
 	if err != nil {
 		return err
 	}
@@ -56,25 +79,37 @@ func (x *Xlsx) load() error {
 		return ErrWorkbookNotExist
 	}
 
-	err = x.fillWorkbook(workbookFile, sheets, files)
+	err = x.fillWorkbook(workbookFile, rels, files)
 	if err != nil {
 		return err
 	}
 
 	sharedStringFile := x.findFile(files, "sharedStrings.xml")
 	if sharedStringFile != nil {
+		x.sharedFile = sharedStringFile
 		err = x.fillSharedStrings(sharedStringFile)
 		if err != nil {
 			return err
 		}
 	}
 
+	x.colors = &colorResolver{}
+	for _, part := range x.workbookParts(relTypeTheme) {
+		if themeFile, ok := files[part]; ok {
+			// A broken theme only leaves theme colors unresolved.
+			x.colors.themeErr = x.fillTheme(themeFile)
+			break
+		}
+	}
+
 	stylesFile := x.findFile(files, "styles.xml")
 	if stylesFile != nil {
 		err = x.fillStyles(stylesFile)
 		if err != nil {
 			return err
 		}
+		x.colors.indexed = x.styles.indexedColors
+		x.styles.resolveColors(x.colors)
 	}
 
 	return nil
@@ -89,33 +124,67 @@ func (x *Xlsx) findFile(files map[string]*zip.File, name string) *zip.File {
 	return nil
 }
 
-func (x *Xlsx) getWorkbookRels(zipFile *zip.File) (map[string]string, error) {
+func (x *Xlsx) getWorkbookRels(zipFile *zip.File) (map[string]relationship, error) {
 	reader, err := zipFile.Open()
 	if err != nil {
 		return nil, err
 	}
 	defer reader.Close()
 
-	rels, err := readWorkbookRels(reader)
+	rels, err := readRelationships(reader)
 	if err != nil {
 		return nil, err
 	}
 
-	sheets := make(map[string]string, len(rels.Relationship))
+	x.workbookRels = rels.Relationship
+
+	result := make(map[string]relationship, len(rels.Relationship))
 	for _, rel := range rels.Relationship {
-		if rel.Type == "http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" {
-			if strings.HasPrefix(rel.Target, "/xl/") {
-				sheets[rel.ID] = rel.Target[1:]
-			} else {
-				sheets[rel.ID] = "xl/" + rel.Target
-			}
+		result[rel.ID] = rel
+	}
+
+	return result, nil
+}
+
+// workbookParts returns the paths of the workbook-level parts of the given
+// relationship type.
+func (x *Xlsx) workbookParts(relType string) []string {
+	var result []string
+	for _, rel := range x.workbookRels {
+		if rel.Type == relType && !rel.isExternal() {
+			result = append(result, resolveTarget("xl/workbook.xml", rel.Target))
 		}
 	}
+	return result
+}
+
+// partRels returns the relationships of a package part by id.
+// A part without relationships has none.
+func (x *Xlsx) partRels(part string) (map[string]relationship, error) {
+	zipFile, ok := x.files[relsPath(part)]
+	if !ok {
+		return nil, nil
+	}
+
+	reader, err := zipFile.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	rels, err := readRelationships(reader)
+	if err != nil {
+		return nil, err
+	}
 
-	return sheets, nil
+	result := make(map[string]relationship, len(rels.Relationship))
+	for _, rel := range rels.Relationship {
+		result[rel.ID] = rel
+	}
+	return result, nil
 }
 
-func (x *Xlsx) fillWorkbook(zipFile *zip.File, sheets map[string]string, files map[string]*zip.File) error {
+func (x *Xlsx) fillWorkbook(zipFile *zip.File, rels map[string]relationship, files map[string]*zip.File) error {
 	reader, err := zipFile.Open()
 	if err != nil {
 		return err
@@ -132,22 +201,59 @@ func (x *Xlsx) fillWorkbook(zipFile *zip.File, sheets map[string]string, files m
 	x.sheetFile = make([]*zip.File, 0, len(wb.Sheets))
 	x.sheetNames = make([]string, 0, len(wb.Sheets))
 	x.sheetNameFile = make(map[string]*zip.File, len(wb.Sheets))
+	x.sheets = make([]SheetInfo, 0, len(wb.Sheets))
 	for _, sheet := range wb.Sheets {
-		path, ok := sheets[sheet.ID]
+		rel, ok := rels[sheet.RID]
+		if !ok {
+			continue
+		}
+		kind, ok := sheetKindByRelType[rel.Type]
 		if !ok {
 			continue
 		}
 
+		path := resolveTarget("xl/workbook.xml", rel.Target)
 		file, ok := files[path]
 		if !ok {
 			continue
 		}
 
+		id, _ := strconv.Atoi(sheet.SheetID)
+		x.sheets = append(x.sheets, SheetInfo{
+			Name:  sheet.Name,
+			ID:    id,
+			RelID: sheet.RID,
+			Path:  path,
+			Kind:  kind,
+			State: parseSheetState(sheet.State),
+		})
+
+		if kind != SheetKindWorksheet {
+			continue
+		}
+
 		x.sheetFile = append(x.sheetFile, file)
 		x.sheetNames = append(x.sheetNames, sheet.Name)
 		x.sheetNameFile[sheet.Name] = file
 	}
 
+	x.definedNames = make([]DefinedName, 0, len(wb.DefinedNames))
+	for _, dn := range wb.DefinedNames {
+		name := DefinedName{
+			Name:    dn.Name,
+			Formula: dn.Value,
+			Hidden:  dn.Hidden,
+		}
+		if dn.LocalSheetID != nil {
+			id := *dn.LocalSheetID
+			if id < 0 || id >= len(wb.Sheets) {
+				continue
+			}
+			name.Scope = wb.Sheets[id].Name
+		}
+		x.definedNames = append(x.definedNames, name)
+	}
+
 	return nil
 }
 
@@ -165,6 +271,20 @@ func (x *Xlsx) fillSharedStrings(zipFile *zip.File) error {
 	return nil
 }
 
+func (x *Xlsx) fillTheme(zipFile *zip.File) error {
+	reader, err := zipFile.Open()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	x.colors.theme, err = readTheme(reader)
+	if err != nil {
+		return fmt.Errorf("can not read theme %s: %w", zipFile.Name, err)
+	}
+	return nil
+}
+
 func (x *Xlsx) fillStyles(zipFile *zip.File) error {
 	reader, err := zipFile.Open()
 	if err != nil {
@@ -185,20 +305,20 @@ func (x *Xlsx) SheetNames() []string {
 	return result
 }
 
-func (x *Xlsx) OpenSheetByName(name string) (*Sheet, error) {
+func (x *Xlsx) OpenSheetByName(name string, opts ...SheetOption) (*Sheet, error) {
 	file, ok := x.sheetNameFile[name]
 	if !ok {
 		return nil, fmt.Errorf("can not find worksheet %s: %w", name, ErrSheetNotFound)
 	}
 
-	return newSheetReader(file, x.sharedStrings, x.styles, x.date1904)
+	return newSheetReader(x, name, file, opts)
 }
 
-func (x *Xlsx) OpenSheetByOrder(n int) (*Sheet, error) {
+func (x *Xlsx) OpenSheetByOrder(n int, opts ...SheetOption) (*Sheet, error) {
 	if n < 0 || n >= len(x.sheetFile) {
 		return nil, fmt.Errorf("can not find worksheet %d: %w", n, ErrSheetNotFound)
 	}
 
 	file := x.sheetFile[n]
-	return newSheetReader(file, x.sharedStrings, x.styles, x.date1904)
+	return newSheetReader(x, x.sheetNames[n], file, opts)
 }